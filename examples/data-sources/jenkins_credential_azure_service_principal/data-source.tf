@@ -0,0 +1,4 @@
+data "jenkins_credential_azure_service_principal" "example" {
+  name   = "name"
+  folder = jenkins_folder.example.id
+}
@@ -0,0 +1,4 @@
+data "jenkins_credential_github_app" "example" {
+  name   = "name"
+  folder = jenkins_folder.example.id
+}
@@ -0,0 +1,4 @@
+data "jenkins_credential_secret_file" "example" {
+  name   = "name"
+  folder = jenkins_folder.example.id
+}
@@ -0,0 +1,14 @@
+data "jenkins_credential_usage" "example" {
+  name   = "name"
+  folder = jenkins_folder.example.id
+}
+
+# Refuse to rotate credentials that builds have recently used.
+resource "terraform_data" "rotation" {
+  lifecycle {
+    precondition {
+      condition     = !data.jenkins_credential_usage.example.in_use
+      error_message = "The credentials are still used by ${join(", ", data.jenkins_credential_usage.example.usage[*].job)}."
+    }
+  }
+}
@@ -0,0 +1,5 @@
+data "jenkins_credentials" "example" {
+  folder   = jenkins_folder.example.id
+  type     = "com.cloudbees.plugins.credentials.impl.UsernamePasswordCredentialsImpl"
+  id_regex = "^deploy-"
+}
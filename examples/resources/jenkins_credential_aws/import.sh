@@ -0,0 +1,9 @@
+# Credentials may be imported by their domain and name, prefixed with the folder if any.
+terraform import jenkins_credential_aws.example job/folder-name/_/name
+
+# The global credentials store omits the folder.
+terraform import jenkins_credential_aws.example _/name
+
+# With Terraform 1.5 or later, configuration for imported credentials may be generated by
+# declaring import blocks and running "terraform plan -generate-config-out=generated.tf".
+# Secrets cannot be read back from Jenkins, so they must be added to the generated configuration.
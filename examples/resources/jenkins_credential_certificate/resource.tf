@@ -0,0 +1,9 @@
+resource "jenkins_credential_certificate" "example" {
+  name     = "example-certificate"
+  keystore = filebase64("certificate.p12")
+  password = "super-secret"
+}
+
+output "certificate_expiry" {
+  value = jenkins_credential_certificate.example.not_after
+}
@@ -0,0 +1,7 @@
+resource "jenkins_credential_docker_server" "example" {
+  name                  = "example-id"
+  description           = "Remote Docker daemon"
+  client_key            = file("/some/path/key.pem")
+  client_certificate    = file("/some/path/cert.pem")
+  server_ca_certificate = file("/some/path/ca.pem")
+}
@@ -0,0 +1,19 @@
+resource "jenkins_credential_domain" "example" {
+  name        = "github.com"
+  description = "Credentials for GitHub"
+
+  specifications = {
+    hostname = {
+      includes = "github.com, *.github.com"
+    }
+    scheme = {
+      schemes = "https"
+    }
+  }
+}
+
+resource "jenkins_credential_secret_text" "example" {
+  name   = "github-token"
+  domain = jenkins_credential_domain.example.name
+  secret = "super-secret"
+}
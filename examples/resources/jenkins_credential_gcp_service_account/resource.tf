@@ -0,0 +1,13 @@
+resource "google_service_account_key" "deployer" {
+  service_account_id = "projects/example-project/serviceAccounts/deployer@example-project.iam.gserviceaccount.com"
+}
+
+resource "jenkins_credential_gcp_service_account" "example" {
+  name        = "example-id"
+  description = "GKE deployer"
+  json_key    = base64decode(google_service_account_key.deployer.private_key)
+}
+
+output "client_email" {
+  value = jenkins_credential_gcp_service_account.example.client_email
+}
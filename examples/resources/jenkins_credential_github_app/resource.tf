@@ -0,0 +1,6 @@
+resource "jenkins_credential_github_app" "example" {
+  name        = "example-github-app"
+  app_id      = "123456"
+  owner       = "example-org"
+  private_key = file("github-app.pkcs8.pem")
+}
@@ -4,3 +4,12 @@ resource "jenkins_credential_username" "example" {
   username = "example"
   password = "super-secret"
 }
+
+# With Terraform 1.11 or later the password may be kept out of the state file.
+# Increment the version to rotate the password within Jenkins.
+resource "jenkins_credential_username" "write_only" {
+  name                = "write-only"
+  username            = "example"
+  password_wo         = "super-secret"
+  password_wo_version = 1
+}
@@ -0,0 +1,4 @@
+resource "jenkins_credential_vault_github_token" "example" {
+  name         = "example-vault-github"
+  access_token = "super-secret"
+}
@@ -0,0 +1,4 @@
+resource "jenkins_credential_vault_kubernetes" "example" {
+  name = "example-vault-kubernetes"
+  role = "jenkins"
+}
@@ -0,0 +1,5 @@
+resource "jenkins_credential_vault_token" "example" {
+  name      = "example-vault-token"
+  namespace = "example"
+  token     = "super-secret"
+}
@@ -0,0 +1,2 @@
+# Credential providers may be imported by their name, one of "aws_secrets_manager", "kubernetes" or "vault".
+terraform import jenkins_credentials_provider_config.example vault
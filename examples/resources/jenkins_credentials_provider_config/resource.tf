@@ -0,0 +1,25 @@
+resource "jenkins_credentials_provider_config" "aws" {
+  aws_secrets_manager = {
+    region   = "us-east-1"
+    role_arn = "arn:aws:iam::123456789012:role/jenkins-secrets"
+
+    filters = [{
+      key    = "tag-key"
+      values = ["jenkins:credentials:type"]
+    }]
+  }
+}
+
+resource "jenkins_credentials_provider_config" "vault" {
+  vault = {
+    url            = "https://vault.example.com"
+    credentials_id = jenkins_credential_vault_approle.example.name
+  }
+}
+
+resource "jenkins_credentials_provider_config" "kubernetes" {
+  kubernetes = {
+    namespace      = "jenkins-secrets"
+    label_selector = "jenkins.io/team=platform"
+  }
+}
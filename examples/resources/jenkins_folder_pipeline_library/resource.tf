@@ -0,0 +1,15 @@
+resource "jenkins_folder" "example" {
+  name = "folder-name"
+}
+
+resource "jenkins_folder_pipeline_library" "example" {
+  name            = "shared"
+  folder          = jenkins_folder.example.id
+  default_version = "main"
+  remote          = "https://github.com/example/shared-library.git"
+  credentials_id  = "github"
+
+  caching = {
+    refresh_time_minutes = 60
+  }
+}
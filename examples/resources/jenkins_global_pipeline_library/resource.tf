@@ -0,0 +1,12 @@
+resource "jenkins_global_pipeline_library" "example" {
+  name            = "shared"
+  default_version = "v1.2.0"
+  implicit        = true
+  remote          = "https://github.com/example/shared-library.git"
+  credentials_id  = "github"
+
+  caching = {
+    refresh_time_minutes = 60
+    excluded_versions    = "main"
+  }
+}
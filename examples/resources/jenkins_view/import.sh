@@ -0,0 +1,8 @@
+# Views may be imported by their name, prefixed with the folder if any.
+terraform import jenkins_view.example folder-name/view-name
+
+# The URL of the view, as found in the browser, may also be used.
+terraform import jenkins_view.example https://jenkins.example.com/job/folder-name/view/view-name/
+
+# With Terraform 1.5 or later, configuration for imported views may be generated by
+# declaring import blocks and running "terraform plan -generate-config-out=generated.tf".
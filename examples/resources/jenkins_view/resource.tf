@@ -0,0 +1,22 @@
+resource "jenkins_folder" "example" {
+  name = "folder-name"
+}
+
+resource "jenkins_view" "example" {
+  name        = "view-name"
+  folder      = jenkins_folder.example.id
+  description = "Builds and deployments for the team."
+
+  include_regex = "deploy-.*"
+  columns = [
+    "hudson.views.StatusColumn",
+    "hudson.views.WeatherColumn",
+    "hudson.views.JobColumn",
+    "hudson.views.LastSuccessColumn",
+    "hudson.views.BuildButtonColumn",
+  ]
+
+  assigned_projects = [
+    "build",
+  ]
+}
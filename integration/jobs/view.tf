@@ -1,5 +1,7 @@
 resource "jenkins_view" "example" {
-  name = "example"
+  name        = "example"
+  description = "Managed by Terraform"
+  columns     = ["hudson.views.StatusColumn", "hudson.views.JobColumn", "hudson.views.LastSuccessColumn"]
   assigned_projects = [
     jenkins_folder.example.name,
   ]
@@ -9,3 +11,22 @@ data "jenkins_view" "example" {
   depends_on = [jenkins_view.example]
   name       = "example"
 }
+
+resource "jenkins_view" "nested" {
+  name = "nested"
+  type = "NestedView"
+}
+
+resource "jenkins_view" "folder" {
+  name   = "folder-view"
+  folder = jenkins_folder.example.id
+  assigned_projects = [
+    jenkins_folder.example_subfolder.name,
+  ]
+}
+
+data "jenkins_view" "folder" {
+  depends_on = [jenkins_view.folder]
+  name       = jenkins_view.folder.name
+  folder     = jenkins_view.folder.folder
+}
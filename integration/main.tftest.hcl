@@ -57,6 +57,10 @@ run "jobs" {
     condition     = data.jenkins_view.example.name == "example"
     error_message = "${data.jenkins_view.example.name} did not contain expected \"example\" value"
   }
+  assert {
+    condition     = data.jenkins_view.folder.url == jenkins_view.folder.url
+    error_message = "${data.jenkins_view.folder.name} did not resolve within its folder"
+  }
 }
 
 run "credentials" {
@@ -2,7 +2,9 @@ package jenkins
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"net/url"
 	"strings"
 
 	jenkins "github.com/bndr/gojenkins"
@@ -52,3 +54,35 @@ func (j *jenkinsAdapter) Credentials() *jenkins.CredentialsManager {
 func (j *jenkinsAdapter) DeleteJobInFolder(ctx context.Context, name string, parentIDs ...string) (bool, error) {
 	return j.DeleteJob(ctx, strings.Join(append(parentIDs, name), "/job/"))
 }
+
+// RunScript executes a Groovy script within the Jenkins script console, returning its output.
+// This is used for controller-level configuration that has no dedicated REST endpoint.
+func (j *jenkinsAdapter) RunScript(ctx context.Context, script string) (string, error) {
+	payload := url.Values{"script": {script}}
+	return j.request(ctx, "POST", "/scriptText", "application/x-www-form-urlencoded", strings.NewReader(payload.Encode()))
+}
+
+// request performs a raw API request against Jenkins, returning the response body.
+// Unsuccessful responses are returned as an error ending with the status code.
+func (j *jenkinsAdapter) request(ctx context.Context, method, endpoint, contentType string, payload io.Reader) (string, error) {
+	ar := jenkins.NewAPIRequest(method, endpoint, payload)
+	if method == "POST" {
+		if err := j.Requester.SetCrumb(ctx, ar); err != nil {
+			return "", err
+		}
+	}
+	if contentType != "" {
+		ar.SetHeader("Content-Type", contentType)
+	}
+
+	output := ""
+	resp, err := j.Requester.Do(ctx, ar, &output)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != 200 {
+		return "", fmt.Errorf("invalid response code %d", resp.StatusCode)
+	}
+
+	return output, nil
+}
@@ -3,6 +3,8 @@ package jenkins
 import (
 	"bytes"
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	jenkins "github.com/bndr/gojenkins"
@@ -64,3 +66,29 @@ func TestJenkinsAdapter_Credentials(t *testing.T) {
 		t.Error("Expected credentials client to match client")
 	}
 }
+
+func TestJenkinsAdapter_RunScript(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/scriptText" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte("Result: " + r.PostForm.Get("script")))
+	}))
+	defer server.Close()
+
+	c := newJenkinsClient(&Config{ServerURL: server.URL})
+	got, err := c.RunScript(context.Background(), "print('a & b')")
+	if err != nil {
+		t.Fatalf("RunScript() error = %v", err)
+	}
+
+	if want := "Result: print('a & b')"; got != want {
+		t.Errorf("RunScript() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,306 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// credentialDomain represents a credentials domain, as stored within a credentials store.
+type credentialDomain struct {
+	XMLName        xml.Name                       `xml:"com.cloudbees.plugins.credentials.domains.Domain"`
+	Name           string                         `xml:"name"`
+	Description    string                         `xml:"description,omitempty"`
+	Specifications credentialDomainSpecifications `xml:"specifications"`
+}
+
+type credentialDomainSpecifications struct {
+	Hostname *credentialDomainHostnameSpecification `xml:"com.cloudbees.plugins.credentials.domains.HostnameSpecification,omitempty"`
+	Scheme   *credentialDomainSchemeSpecification   `xml:"com.cloudbees.plugins.credentials.domains.SchemeSpecification,omitempty"`
+	Path     *credentialDomainPathSpecification     `xml:"com.cloudbees.plugins.credentials.domains.PathSpecification,omitempty"`
+	Other    []xmlRawProperty                       `xml:",any"`
+}
+
+type credentialDomainHostnameSpecification struct {
+	Includes string `xml:"includes"`
+	Excludes string `xml:"excludes"`
+}
+
+type credentialDomainSchemeSpecification struct {
+	Schemes string `xml:"schemes"`
+}
+
+type credentialDomainPathSpecification struct {
+	Includes      string `xml:"includes"`
+	Excludes      string `xml:"excludes"`
+	CaseSensitive bool   `xml:"caseSensitive"`
+}
+
+// credentialStoreURL returns the URL of the credentials store for the given folder,
+// or the system credentials store if no folder is given.
+func credentialStoreURL(folder string) string {
+	folder = formatFolderName(folder)
+	if folder == "" {
+		return "/credentials/store/system"
+	}
+
+	return fmt.Sprintf("/job/%s/credentials/store/folder", folder)
+}
+
+// credentialDomainURL returns the URL of the given domain within the credentials store of the folder.
+func credentialDomainURL(folder, domain string) string {
+	return fmt.Sprintf("%s/domain/%s", credentialStoreURL(folder), url.PathEscape(domain))
+}
+
+// GetCredentialDomain retrieves the configuration of a credentials domain.
+func (j *jenkinsAdapter) GetCredentialDomain(ctx context.Context, folder, name string) (*credentialDomain, error) {
+	output, err := j.request(ctx, "GET", credentialDomainURL(folder, name)+"/config.xml", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &credentialDomain{}
+	if err := xml.Unmarshal(handleXml(output), ret); err != nil {
+		return nil, fmt.Errorf("could not parse credential domain %q: %w", name, err)
+	}
+
+	return ret, nil
+}
+
+// CreateCredentialDomain adds a new credentials domain to the credentials store of the folder.
+func (j *jenkinsAdapter) CreateCredentialDomain(ctx context.Context, folder string, domain *credentialDomain) error {
+	payload, err := xml.Marshal(domain)
+	if err != nil {
+		return err
+	}
+
+	_, err = j.request(ctx, "POST", credentialStoreURL(folder)+"/createDomain", "application/xml", strings.NewReader(string(payload)))
+	return err
+}
+
+// UpdateCredentialDomain replaces the configuration of an existing credentials domain.
+func (j *jenkinsAdapter) UpdateCredentialDomain(ctx context.Context, folder, name string, domain *credentialDomain) error {
+	payload, err := xml.Marshal(domain)
+	if err != nil {
+		return err
+	}
+
+	_, err = j.request(ctx, "POST", credentialDomainURL(folder, name)+"/config.xml", "application/xml", strings.NewReader(string(payload)))
+	return err
+}
+
+// DeleteCredentialDomain removes a credentials domain, along with all credentials stored within it.
+func (j *jenkinsAdapter) DeleteCredentialDomain(ctx context.Context, folder, name string) error {
+	_, err := j.request(ctx, "POST", credentialDomainURL(folder, name)+"/doDelete", "", nil)
+	return err
+}
+
+// credentialDomainFullName returns the name Jenkins uses to identify the given domain when
+// moving credentials, in the form "[<folder>/]<store>/<domain>". Jenkins splits the name on the
+// store of the credentials being moved, so credentials can only be moved between stores of the
+// same kind: between domains of the global store, or between the stores of folders.
+func credentialDomainFullName(folder, domain string) string {
+	folders := extractFolders(folder)
+	if len(folders) == 0 {
+		return "system/" + domain
+	}
+
+	return strings.Join(folders, "/") + "/folder/" + domain
+}
+
+// MoveCredential relocates the credentials with the given ID to another domain and/or
+// folder, retaining their ID and secrets so that jobs referencing them are not disrupted.
+func (j *jenkinsAdapter) MoveCredential(ctx context.Context, folder, domain, id, destinationFolder, destinationDomain string) error {
+	if len(extractFolders(folder)) == 0 != (len(extractFolders(destinationFolder)) == 0) {
+		return fmt.Errorf("credentials cannot be moved between the global credentials store and a folder")
+	}
+
+	payload := url.Values{"destination": {credentialDomainFullName(destinationFolder, destinationDomain)}}
+	endpoint := fmt.Sprintf("%s/credential/%s/doMove", credentialDomainURL(folder, domain), url.PathEscape(id))
+
+	if _, err := j.request(ctx, "POST", endpoint, "application/x-www-form-urlencoded", strings.NewReader(payload.Encode())); err != nil {
+		return err
+	}
+
+	// Jenkins redirects to the root page when the destination cannot be resolved, so confirm the move took place
+	destination := fmt.Sprintf("%s/credential/%s/config.xml", credentialDomainURL(destinationFolder, destinationDomain), url.PathEscape(id))
+	if _, err := j.request(ctx, "GET", destination, "", nil); err != nil {
+		return fmt.Errorf("credentials were not found at %q after moving them: %w", credentialDomainFullName(destinationFolder, destinationDomain), err)
+	}
+
+	return nil
+}
+
+// GetCredentialFingerprint returns the hash Jenkins computes over the given credentials, including their secrets
+// but excluding their description. Jenkins only records the fingerprint once the credentials have been used by a
+// build, so an empty hash is returned for credentials that have not been used since they last changed.
+func (j *jenkinsAdapter) GetCredentialFingerprint(ctx context.Context, folder, domain, id string) (string, error) {
+	cred := struct {
+		Fingerprint *struct {
+			Hash string `json:"hash"`
+		} `json:"fingerprint"`
+	}{}
+
+	endpoint := fmt.Sprintf("%s/credential/%s", credentialDomainURL(folder, domain), url.PathEscape(id))
+	resp, err := j.Requester.GetJSON(ctx, endpoint, &cred, map[string]string{"tree": "fingerprint[hash]"})
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != 200 {
+		return "", fmt.Errorf("invalid response code %d", resp.StatusCode)
+	}
+
+	if cred.Fingerprint == nil {
+		return "", nil
+	}
+	return cred.Fingerprint.Hash, nil
+}
+
+// credentialSummary describes the non-secret attributes of credentials stored within a domain.
+type credentialSummary struct {
+	ID          string
+	Type        string
+	Description string
+	Scope       string
+	Fingerprint string
+}
+
+// ListCredentials returns a summary of the credentials stored within the given domain of the folder, optionally
+// limited to those with an ID matching idRegex. The JSON API only exposes the display name of the credentials type
+// and not the scope, so they are read from the configuration of each listed credential instead. This takes a
+// request per credential, and requires the permission to read their configuration.
+func (j *jenkinsAdapter) ListCredentials(ctx context.Context, folder, domain string, idRegex *regexp.Regexp) ([]credentialSummary, error) {
+	list := struct {
+		Credentials []struct {
+			ID          string `json:"id"`
+			Description string `json:"description"`
+			Fingerprint *struct {
+				Hash string `json:"hash"`
+			} `json:"fingerprint"`
+		} `json:"credentials"`
+	}{}
+
+	tree := map[string]string{"tree": "credentials[id,description,fingerprint[hash]]"}
+	resp, err := j.Requester.GetJSON(ctx, credentialDomainURL(folder, domain), &list, tree)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("invalid response code %d", resp.StatusCode)
+	}
+
+	ret := make([]credentialSummary, 0, len(list.Credentials))
+	for _, cred := range list.Credentials {
+		if idRegex != nil && !idRegex.MatchString(cred.ID) {
+			continue
+		}
+
+		endpoint := fmt.Sprintf("%s/credential/%s/config.xml", credentialDomainURL(folder, domain), url.PathEscape(cred.ID))
+		config, err := j.request(ctx, "GET", endpoint, "", nil)
+		if err != nil {
+			return nil, fmt.Errorf("could not read credentials %q: %w", cred.ID, err)
+		}
+
+		summary, err := parseCredentialSummary(config)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse credentials %q: %w", cred.ID, err)
+		}
+
+		summary.ID = cred.ID
+		summary.Description = cred.Description
+		if cred.Fingerprint != nil {
+			summary.Fingerprint = cred.Fingerprint.Hash
+		}
+		ret = append(ret, summary)
+	}
+
+	return ret, nil
+}
+
+// parseCredentialSummary extracts the type and scope of credentials from their configuration.
+func parseCredentialSummary(config string) (credentialSummary, error) {
+	parsed := struct {
+		XMLName xml.Name
+		Scope   string `xml:"scope"`
+	}{}
+	if err := xml.Unmarshal(handleXml(config), &parsed); err != nil {
+		return credentialSummary{}, err
+	}
+	if parsed.XMLName.Local == "" {
+		return credentialSummary{}, errors.New("missing credentials type")
+	}
+
+	return credentialSummary{
+		// XStream encodes the "$" of nested classes as "_-"
+		Type:  strings.ReplaceAll(parsed.XMLName.Local, "_-", "$"),
+		Scope: parsed.Scope,
+	}, nil
+}
+
+// credentialUsage summarizes the builds of a single job that used credentials. The builds are not listed
+// individually, as long-lived jobs may have used the credentials in thousands of builds.
+type credentialUsage struct {
+	Job        string
+	FirstBuild int64
+	LastBuild  int64
+	BuildCount int64
+}
+
+// GetCredentialUsage returns the jobs and builds that used the given credentials, as tracked by their fingerprint.
+// Jenkins only records usage for builds that resolved the credentials, and discards records of deleted builds.
+func (j *jenkinsAdapter) GetCredentialUsage(ctx context.Context, folder, domain, id string) ([]credentialUsage, error) {
+	cred := struct {
+		Fingerprint *struct {
+			Usage []struct {
+				Name   string `json:"name"`
+				Ranges struct {
+					Ranges []struct {
+						Start int64 `json:"start"`
+						End   int64 `json:"end"`
+					} `json:"ranges"`
+				} `json:"ranges"`
+			} `json:"usage"`
+		} `json:"fingerprint"`
+	}{}
+
+	endpoint := fmt.Sprintf("%s/credential/%s", credentialDomainURL(folder, domain), url.PathEscape(id))
+	query := map[string]string{"tree": "fingerprint[usage[name,ranges[ranges[start,end]]]]"}
+	resp, err := j.Requester.GetJSON(ctx, endpoint, &cred, query)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("invalid response code %d", resp.StatusCode)
+	}
+
+	ret := []credentialUsage{}
+	if cred.Fingerprint == nil {
+		// The credentials have never been used by a build
+		return ret, nil
+	}
+
+	for _, usage := range cred.Fingerprint.Usage {
+		item := credentialUsage{Job: formatFolderID(strings.Split(usage.Name, "/"))}
+
+		// Ranges exclude their end
+		for _, r := range usage.Ranges.Ranges {
+			if r.End <= r.Start {
+				continue
+			}
+			if item.BuildCount == 0 || r.Start < item.FirstBuild {
+				item.FirstBuild = r.Start
+			}
+			if r.End-1 > item.LastBuild {
+				item.LastBuild = r.End - 1
+			}
+			item.BuildCount += r.End - r.Start
+		}
+		ret = append(ret, item)
+	}
+
+	return ret, nil
+}
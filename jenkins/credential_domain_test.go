@@ -0,0 +1,320 @@
+package jenkins
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func Test_credentialStoreURL(t *testing.T) {
+	tests := []struct {
+		folder string
+		want   string
+	}{
+		{folder: "", want: "/credentials/store/system"},
+		{folder: "foo", want: "/job/foo/credentials/store/folder"},
+		{folder: "/job/foo/job/bar", want: "/job/foo/job/bar/credentials/store/folder"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.folder, func(t *testing.T) {
+			if got := credentialStoreURL(tt.folder); got != tt.want {
+				t.Errorf("credentialStoreURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJenkinsAdapter_CredentialDomain(t *testing.T) {
+	stored := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/job/foo/credentials/store/folder/createDomain":
+			body, _ := io.ReadAll(r.Body)
+			stored = string(body)
+		case r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/job/foo/credentials/store/folder/domain/github.com/config.xml"):
+			if stored == "" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte("<?xml version='1.1' encoding='UTF-8'?>\n" + stored))
+		case r.Method == "POST" && r.URL.Path == "/job/foo/credentials/store/folder/domain/github.com/doDelete":
+			stored = ""
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	c := newJenkinsClient(&Config{ServerURL: server.URL})
+
+	if _, err := c.GetCredentialDomain(ctx, "foo", "github.com"); err == nil || !strings.HasSuffix(err.Error(), "404") {
+		t.Fatalf("GetCredentialDomain() error = %v, want 404", err)
+	}
+
+	domain := &credentialDomain{
+		Name:        "github.com",
+		Description: "GitHub",
+		Specifications: credentialDomainSpecifications{
+			Hostname: &credentialDomainHostnameSpecification{Includes: "github.com, *.github.com"},
+			Path:     &credentialDomainPathSpecification{Includes: "/example/*", CaseSensitive: true},
+		},
+	}
+	if err := c.CreateCredentialDomain(ctx, "foo", domain); err != nil {
+		t.Fatalf("CreateCredentialDomain() error = %v", err)
+	}
+
+	got, err := c.GetCredentialDomain(ctx, "foo", "github.com")
+	if err != nil {
+		t.Fatalf("GetCredentialDomain() error = %v", err)
+	}
+	if got.Name != domain.Name || got.Description != domain.Description {
+		t.Errorf("GetCredentialDomain() = %+v, want %+v", got, domain)
+	}
+	if got.Specifications.Hostname == nil || *got.Specifications.Hostname != *domain.Specifications.Hostname {
+		t.Errorf("GetCredentialDomain() hostname = %+v, want %+v", got.Specifications.Hostname, domain.Specifications.Hostname)
+	}
+	if got.Specifications.Scheme != nil {
+		t.Errorf("GetCredentialDomain() scheme = %+v, want nil", got.Specifications.Scheme)
+	}
+	if got.Specifications.Path == nil || *got.Specifications.Path != *domain.Specifications.Path {
+		t.Errorf("GetCredentialDomain() path = %+v, want %+v", got.Specifications.Path, domain.Specifications.Path)
+	}
+
+	if err := c.DeleteCredentialDomain(ctx, "foo", "github.com"); err != nil {
+		t.Fatalf("DeleteCredentialDomain() error = %v", err)
+	}
+	if stored != "" {
+		t.Errorf("DeleteCredentialDomain() did not remove the domain")
+	}
+}
+
+// newMoveCredentialServer emulates the credential stores of Jenkins, resolving the destination of moves in the
+// same way as the credentials plugin: by splitting it on the name of the store holding the credentials.
+func newMoveCredentialServer(credentials, domains map[string]bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		base, action, _ := strings.Cut(r.URL.Path, "/credential/example/")
+		if !credentials[base] {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch {
+		case r.Method == "GET" && action == "config.xml/":
+			_, _ = w.Write([]byte("<example/>"))
+		case r.Method == "POST" && action == "doMove":
+			_ = r.ParseForm()
+			destination := r.PostForm.Get("destination")
+
+			store := "system"
+			if strings.HasPrefix(base, "/job/") {
+				store = "folder"
+			}
+			split := strings.LastIndex(destination, store+"/")
+			if split == -1 {
+				// Jenkins redirects to the root page when the destination cannot be resolved
+				return
+			}
+
+			folder := formatFolderID(strings.Split(destination[:split], "/"))
+			target := credentialDomainURL(folder, destination[split+len(store)+1:])
+			if !domains[target] {
+				return
+			}
+			delete(credentials, base)
+			credentials[target] = true
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestJenkinsAdapter_MoveCredential(t *testing.T) {
+	tests := []struct {
+		name              string
+		folder            string
+		domain            string
+		destinationFolder string
+		destinationDomain string
+		wantErr           bool
+	}{
+		{name: "between global domains", domain: "_", destinationDomain: "github.com"},
+		{name: "between folders", folder: "/job/foo", domain: "_", destinationFolder: "/job/foo/job/bar", destinationDomain: "github.com"},
+		{name: "between domains of a folder", folder: "foo", domain: "_", destinationFolder: "foo", destinationDomain: "github.com"},
+		{name: "from global to folder", domain: "_", destinationFolder: "/job/foo", destinationDomain: "_", wantErr: true},
+		{name: "from folder to global", folder: "/job/foo", domain: "_", destinationDomain: "_", wantErr: true},
+		{name: "to missing domain", folder: "/job/foo", domain: "_", destinationFolder: "/job/bar", destinationDomain: "missing", wantErr: true},
+	}
+	missing := credentialDomainURL("/job/bar", "missing")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			credentials := map[string]bool{credentialDomainURL(tt.folder, tt.domain): true}
+			domains := map[string]bool{credentialDomainURL(tt.destinationFolder, tt.destinationDomain): true}
+			delete(domains, missing)
+			server := newMoveCredentialServer(credentials, domains)
+			defer server.Close()
+
+			c := newJenkinsClient(&Config{ServerURL: server.URL})
+			err := c.MoveCredential(context.Background(), tt.folder, tt.domain, "example", tt.destinationFolder, tt.destinationDomain)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MoveCredential() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !credentials[credentialDomainURL(tt.destinationFolder, tt.destinationDomain)] {
+				t.Errorf("MoveCredential() did not move the credentials, got %v", credentials)
+			}
+		})
+	}
+}
+
+func Test_credentialDomainFullName(t *testing.T) {
+	if got, want := credentialDomainFullName("", "_"), "system/_"; got != want {
+		t.Errorf("credentialDomainFullName() = %q, want %q", got, want)
+	}
+	if got, want := credentialDomainFullName("foo/job/bar", "github.com"), "foo/bar/folder/github.com"; got != want {
+		t.Errorf("credentialDomainFullName() = %q, want %q", got, want)
+	}
+}
+
+func TestJenkinsAdapter_GetCredentialFingerprint(t *testing.T) {
+	credentials := map[string]string{
+		"used":   `{"_class":"com.cloudbees.plugins.credentials.CredentialsStoreAction$CredentialsWrapper","fingerprint":{"hash":"abc123"}}`,
+		"unused": `{"_class":"com.cloudbees.plugins.credentials.CredentialsStoreAction$CredentialsWrapper","fingerprint":null}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/job/foo/credentials/store/folder/domain/_/credential/"), "/api/json")
+		body, ok := credentials[id]
+		if r.Method != "GET" || !ok || r.URL.Query().Get("tree") != "fingerprint[hash]" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	c := newJenkinsClient(&Config{ServerURL: server.URL})
+
+	tests := []struct {
+		id      string
+		want    string
+		wantErr bool
+	}{
+		{id: "used", want: "abc123"},
+		{id: "unused", want: ""},
+		{id: "missing", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			got, err := c.GetCredentialFingerprint(ctx, "foo", "_", tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetCredentialFingerprint() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("GetCredentialFingerprint() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJenkinsAdapter_ListCredentials(t *testing.T) {
+	configReads := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/config.xml/") {
+			configReads++
+		}
+
+		switch {
+		case r.URL.Path == "/job/foo/credentials/store/folder/domain/_/api/json" && r.URL.Query().Get("tree") == "credentials[id,description,fingerprint[hash]]":
+			_, _ = w.Write([]byte(`{"credentials":[
+				{"id":"username","description":"A username","fingerprint":{"hash":"abc123"}},
+				{"id":"token","description":"","fingerprint":null}
+			]}`))
+		case r.URL.Path == "/job/foo/credentials/store/folder/domain/_/credential/username/config.xml/":
+			_, _ = w.Write([]byte("<?xml version='1.1' encoding='UTF-8'?>\n<com.cloudbees.plugins.credentials.impl.UsernamePasswordCredentialsImpl><scope>GLOBAL</scope><password>{AQAAABAAAAAQ}</password></com.cloudbees.plugins.credentials.impl.UsernamePasswordCredentialsImpl>"))
+		case r.URL.Path == "/job/foo/credentials/store/folder/domain/_/credential/token/config.xml/":
+			_, _ = w.Write([]byte("<example.Outer_-TokenCredentials><scope>SYSTEM</scope></example.Outer_-TokenCredentials>"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := newJenkinsClient(&Config{ServerURL: server.URL})
+	got, err := c.ListCredentials(context.Background(), "foo", "_", nil)
+	if err != nil {
+		t.Fatalf("ListCredentials() error = %v", err)
+	}
+
+	want := []credentialSummary{
+		{ID: "username", Type: "com.cloudbees.plugins.credentials.impl.UsernamePasswordCredentialsImpl", Description: "A username", Scope: "GLOBAL", Fingerprint: "abc123"},
+		{ID: "token", Type: "example.Outer$TokenCredentials", Scope: "SYSTEM"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListCredentials() = %+v, want %+v", got, want)
+	}
+
+	// Filtered credentials have their configuration read only when they match
+	configReads = 0
+	got, err = c.ListCredentials(context.Background(), "foo", "_", regexp.MustCompile("^tok"))
+	if err != nil {
+		t.Fatalf("ListCredentials() error = %v", err)
+	}
+	if len(got) != 1 || got[0].ID != "token" || configReads != 1 {
+		t.Errorf("ListCredentials() = %+v after %d configuration reads, want only token after 1", got, configReads)
+	}
+
+	if _, err := c.ListCredentials(context.Background(), "missing", "_", nil); err == nil {
+		t.Errorf("ListCredentials() expected an error for a missing folder")
+	}
+}
+
+func TestJenkinsAdapter_GetCredentialUsage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/credentials/store/system/domain/_/credential/used/api/json":
+			_, _ = w.Write([]byte(`{"fingerprint":{"usage":[
+				{"name":"folder/deploy","ranges":{"ranges":[{"start":1,"end":3},{"start":5,"end":6}]}},
+				{"name":"other","ranges":{"ranges":[{"start":7,"end":8}]}}
+			]}}`))
+		case "/credentials/store/system/domain/_/credential/unused/api/json":
+			_, _ = w.Write([]byte(`{"fingerprint":null}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	c := newJenkinsClient(&Config{ServerURL: server.URL})
+
+	got, err := c.GetCredentialUsage(ctx, "", "_", "used")
+	if err != nil {
+		t.Fatalf("GetCredentialUsage() error = %v", err)
+	}
+	want := []credentialUsage{
+		{Job: "/job/folder/job/deploy", FirstBuild: 1, LastBuild: 5, BuildCount: 3},
+		{Job: "/job/other", FirstBuild: 7, LastBuild: 7, BuildCount: 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetCredentialUsage() = %+v, want %+v", got, want)
+	}
+
+	got, err = c.GetCredentialUsage(ctx, "", "_", "unused")
+	if err != nil {
+		t.Fatalf("GetCredentialUsage() error = %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("GetCredentialUsage() = %+v, want no usage", got)
+	}
+
+	if _, err := c.GetCredentialUsage(ctx, "", "_", "missing"); err == nil {
+		t.Errorf("GetCredentialUsage() expected an error for missing credentials")
+	}
+}
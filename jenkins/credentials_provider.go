@@ -0,0 +1,167 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// configurationAsCode represents the subset of a Jenkins Configuration as Code document managed by this provider.
+type configurationAsCode struct {
+	Unclassified credentialsProviderConfiguration `yaml:"unclassified"`
+}
+
+// credentialsProviderConfiguration holds the system configuration of the credential providers, which resolve
+// credentials from external secret stores rather than storing them within Jenkins.
+type credentialsProviderConfiguration struct {
+	AWSSecretsManager *awsSecretsManagerProviderConfiguration `yaml:"awsCredentialsProvider,omitempty"`
+	Vault             *vaultProviderConfiguration             `yaml:"hashicorpVault,omitempty"`
+}
+
+type awsSecretsManagerProviderConfiguration struct {
+	Cache       *bool                         `yaml:"cache,omitempty"`
+	Client      *awsSecretsManagerClient      `yaml:"client,omitempty"`
+	ListSecrets *awsSecretsManagerListSecrets `yaml:"listSecrets,omitempty"`
+}
+
+type awsSecretsManagerClient struct {
+	CredentialsProvider   *awsSecretsManagerCredentialsProvider   `yaml:"credentialsProvider,omitempty"`
+	EndpointConfiguration *awsSecretsManagerEndpointConfiguration `yaml:"endpointConfiguration,omitempty"`
+	Region                string                                  `yaml:"region,omitempty"`
+}
+
+type awsSecretsManagerCredentialsProvider struct {
+	AssumeRole *awsSecretsManagerAssumeRole `yaml:"assumeRole,omitempty"`
+}
+
+type awsSecretsManagerAssumeRole struct {
+	RoleArn         string `yaml:"roleArn"`
+	RoleSessionName string `yaml:"roleSessionName,omitempty"`
+}
+
+type awsSecretsManagerEndpointConfiguration struct {
+	ServiceEndpoint string `yaml:"serviceEndpoint"`
+	SigningRegion   string `yaml:"signingRegion"`
+}
+
+type awsSecretsManagerListSecrets struct {
+	Filters []awsSecretsManagerFilter `yaml:"filters,omitempty"`
+}
+
+type awsSecretsManagerFilter struct {
+	Key    string   `yaml:"key"`
+	Values []string `yaml:"values"`
+}
+
+type vaultProviderConfiguration struct {
+	Configuration *vaultConfiguration `yaml:"configuration,omitempty"`
+}
+
+type vaultConfiguration struct {
+	VaultURL            string `yaml:"vaultUrl,omitempty"`
+	VaultCredentialID   string `yaml:"vaultCredentialId,omitempty"`
+	VaultNamespace      string `yaml:"vaultNamespace,omitempty"`
+	PrefixPath          string `yaml:"prefixPath,omitempty"`
+	EngineVersion       int64  `yaml:"engineVersion,omitempty"`
+	SkipSslVerification bool   `yaml:"skipSslVerification,omitempty"`
+	Timeout             int64  `yaml:"timeout,omitempty"`
+}
+
+// kubernetesProviderConfiguration holds the settings of the Kubernetes Credentials Provider. These are read
+// from system properties of the controller rather than its system configuration.
+type kubernetesProviderConfiguration struct {
+	Installed     bool   `json:"installed"`
+	Namespace     string `json:"namespace"`
+	LabelSelector string `json:"labelSelector"`
+}
+
+const (
+	// kubernetesProviderReadScript prints the settings of the Kubernetes Credentials Provider as JSON.
+	kubernetesProviderReadScript = `
+import groovy.json.JsonOutput
+
+def installed = jenkins.model.Jenkins.get().pluginManager.getPlugin('kubernetes-credentials-provider')?.isActive() ?: false
+print(JsonOutput.toJson([
+	installed: installed,
+	namespace: System.getProperty('kubernetes.namespace'),
+	labelSelector: System.getProperty('com.cloudbees.jenkins.plugins.kubernetes_credentials_provider.KubernetesCredentialProvider.labelSelector'),
+]))`
+
+	// kubernetesProviderWriteScript sets the settings of the Kubernetes Credentials Provider from the given
+	// base64 encoded namespace and label selector, restarting the provider so that they take effect.
+	kubernetesProviderWriteScript = `
+def decode = { new String(Base64.decoder.decode(it), 'UTF-8') }
+def set = { name, value -> value ? System.setProperty(name, value) : System.clearProperty(name) }
+set('kubernetes.namespace', decode('%s'))
+set('com.cloudbees.jenkins.plugins.kubernetes_credentials_provider.KubernetesCredentialProvider.labelSelector', decode('%s'))
+
+def provider = jenkins.model.Jenkins.get().getExtensionList('com.cloudbees.jenkins.plugins.kubernetes_credentials_provider.KubernetesCredentialProvider')[0]
+provider.stopWatchingForSecrets()
+provider.startWatchingForSecrets()
+print('OK')`
+)
+
+// GetCredentialsProviderConfiguration retrieves the configuration of the credential providers by exporting
+// the Configuration as Code document of the controller. Providers whose plugins are not installed are nil.
+func (j *jenkinsAdapter) GetCredentialsProviderConfiguration(ctx context.Context) (*credentialsProviderConfiguration, error) {
+	output, err := j.request(ctx, "POST", "/configuration-as-code/export", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &configurationAsCode{}
+	if err := yaml.Unmarshal([]byte(output), ret); err != nil {
+		return nil, fmt.Errorf("could not parse configuration as code export: %w", err)
+	}
+
+	return &ret.Unclassified, nil
+}
+
+// ApplyCredentialsProviderConfiguration applies the given credential providers through Configuration as Code.
+// Providers that are nil are left untouched.
+func (j *jenkinsAdapter) ApplyCredentialsProviderConfiguration(ctx context.Context, config *credentialsProviderConfiguration) error {
+	payload, err := yaml.Marshal(configurationAsCode{Unclassified: *config})
+	if err != nil {
+		return fmt.Errorf("could not render configuration as code: %w", err)
+	}
+
+	_, err = j.request(ctx, "POST", "/configuration-as-code/apply", "application/x-yaml", strings.NewReader(string(payload)))
+	return err
+}
+
+// GetKubernetesProviderConfiguration retrieves the settings of the Kubernetes Credentials Provider from the
+// system properties of the controller.
+func (j *jenkinsAdapter) GetKubernetesProviderConfiguration(ctx context.Context) (*kubernetesProviderConfiguration, error) {
+	output, err := j.RunScript(ctx, kubernetesProviderReadScript)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &kubernetesProviderConfiguration{}
+	if err := json.Unmarshal([]byte(output), ret); err != nil {
+		return nil, fmt.Errorf("could not parse kubernetes provider settings: %w: %s", err, strings.TrimSpace(output))
+	}
+
+	return ret, nil
+}
+
+// ApplyKubernetesProviderConfiguration sets the system properties read by the Kubernetes Credentials Provider
+// and restarts it. Empty settings are cleared, restoring the defaults of the provider.
+func (j *jenkinsAdapter) ApplyKubernetesProviderConfiguration(ctx context.Context, config *kubernetesProviderConfiguration) error {
+	encode := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+	script := fmt.Sprintf(kubernetesProviderWriteScript, encode(config.Namespace), encode(config.LabelSelector))
+
+	output, err := j.RunScript(ctx, script)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(output) != "OK" {
+		return fmt.Errorf("could not update kubernetes provider settings: %s", strings.TrimSpace(output))
+	}
+
+	return nil
+}
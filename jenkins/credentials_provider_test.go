@@ -0,0 +1,156 @@
+package jenkins
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestJenkinsAdapter_CredentialsProviderConfiguration(t *testing.T) {
+	applied := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/configuration-as-code/export":
+			_, _ = w.Write([]byte(`jenkins:
+  systemMessage: "Hello"
+unclassified:
+  hashicorpVault:
+    configuration:
+      engineVersion: 1
+      vaultCredentialId: "vault-approle"
+      vaultUrl: "https://vault.example.com"
+  location:
+    url: "http://localhost:8080/"
+`))
+		case r.Method == "POST" && r.URL.Path == "/configuration-as-code/apply":
+			body, _ := io.ReadAll(r.Body)
+			applied = string(body)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	c := newJenkinsClient(&Config{ServerURL: server.URL})
+
+	got, err := c.GetCredentialsProviderConfiguration(ctx)
+	if err != nil {
+		t.Fatalf("GetCredentialsProviderConfiguration() error = %v", err)
+	}
+	if got.AWSSecretsManager != nil {
+		t.Errorf("GetCredentialsProviderConfiguration() AWS = %+v, want nil", got.AWSSecretsManager)
+	}
+	want := &vaultConfiguration{VaultURL: "https://vault.example.com", VaultCredentialID: "vault-approle", EngineVersion: 1}
+	if got.Vault == nil || !reflect.DeepEqual(got.Vault.Configuration, want) {
+		t.Errorf("GetCredentialsProviderConfiguration() Vault = %+v, want %+v", got.Vault, want)
+	}
+
+	err = c.ApplyCredentialsProviderConfiguration(ctx, &credentialsProviderConfiguration{
+		AWSSecretsManager: &awsSecretsManagerProviderConfiguration{
+			Client: &awsSecretsManagerClient{Region: "us-east-1"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ApplyCredentialsProviderConfiguration() error = %v", err)
+	}
+	if !strings.Contains(applied, "unclassified:\n    awsCredentialsProvider:\n        client:\n            region: us-east-1\n") {
+		t.Errorf("ApplyCredentialsProviderConfiguration() applied %q", applied)
+	}
+	if strings.Contains(applied, "hashicorpVault") {
+		t.Errorf("ApplyCredentialsProviderConfiguration() applied unconfigured providers: %q", applied)
+	}
+}
+
+func TestJenkinsAdapter_KubernetesProviderConfiguration(t *testing.T) {
+	script := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/scriptText" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		script = r.PostForm.Get("script")
+		if strings.Contains(script, "JsonOutput") {
+			_, _ = w.Write([]byte(`{"installed":true,"namespace":"jenkins","labelSelector":null}`))
+			return
+		}
+		_, _ = w.Write([]byte("OK\n"))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	c := newJenkinsClient(&Config{ServerURL: server.URL})
+
+	got, err := c.GetKubernetesProviderConfiguration(ctx)
+	if err != nil {
+		t.Fatalf("GetKubernetesProviderConfiguration() error = %v", err)
+	}
+	if want := (&kubernetesProviderConfiguration{Installed: true, Namespace: "jenkins"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetKubernetesProviderConfiguration() = %+v, want %+v", got, want)
+	}
+
+	err = c.ApplyKubernetesProviderConfiguration(ctx, &kubernetesProviderConfiguration{LabelSelector: "env in (prod)"})
+	if err != nil {
+		t.Fatalf("ApplyKubernetesProviderConfiguration() error = %v", err)
+	}
+	// The settings are passed base64 encoded, so that they cannot break out of the script
+	if !strings.Contains(script, "decode('')") || !strings.Contains(script, "decode('ZW52IGluIChwcm9kKQ==')") {
+		t.Errorf("ApplyKubernetesProviderConfiguration() script = %s", script)
+	}
+}
+
+func TestCredentialsProviderConfigResourceModel_expand(t *testing.T) {
+	m := &credentialsProviderConfigResourceModel{
+		AWSSecretsManager: &credentialsProviderConfigAWSSecretsManagerModel{
+			Region:          types.StringValue("eu-west-1"),
+			EndpointURL:     types.StringNull(),
+			SigningRegion:   types.StringNull(),
+			RoleArn:         types.StringValue("arn:aws:iam::123456789012:role/jenkins"),
+			RoleSessionName: types.StringNull(),
+			Cache:           types.BoolValue(false),
+			Filters: []credentialsProviderConfigAWSFilterModel{
+				{Key: types.StringValue("tag-key"), Values: []types.String{types.StringValue("jenkins")}},
+			},
+		},
+	}
+
+	got := m.expand()
+	if got.Vault != nil {
+		t.Errorf("expand() Vault = %+v, want nil", got.Vault)
+	}
+	if m.provider() != credentialsProviderAWSSecretsManager {
+		t.Errorf("provider() = %q, want %q", m.provider(), credentialsProviderAWSSecretsManager)
+	}
+
+	// Flattening the expanded configuration should return the original model
+	if flattened := flattenAWSSecretsManagerProviderConfiguration(got.AWSSecretsManager); !reflect.DeepEqual(flattened, m.AWSSecretsManager) {
+		t.Errorf("flattenAWSSecretsManagerProviderConfiguration() = %+v, want %+v", flattened, m.AWSSecretsManager)
+	}
+}
+
+func Test_flattenVaultProviderConfiguration(t *testing.T) {
+	got := flattenVaultProviderConfiguration(&vaultConfiguration{VaultURL: "https://vault.example.com"})
+	want := &credentialsProviderConfigVaultModel{
+		URL:                 types.StringValue("https://vault.example.com"),
+		CredentialsID:       types.StringNull(),
+		Namespace:           types.StringNull(),
+		PrefixPath:          types.StringNull(),
+		EngineVersion:       types.Int64Value(2),
+		SkipSslVerification: types.BoolValue(false),
+		Timeout:             types.Int64Value(60),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("flattenVaultProviderConfiguration() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,90 @@
+package jenkins
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJenkinsCredentialAzureServicePrincipalDataSource_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_credential_azure_service_principal foo {
+				  name = "tf-acc-test-%s"
+				  description = "Terraform acceptance tests %s"
+				  subscription_id = "123"
+				  client_id = "abc"
+				  client_secret = "super-secret"
+				  tenant = "xyz"
+				}
+
+				data jenkins_credential_azure_service_principal foo {
+					name   = jenkins_credential_azure_service_principal.foo.name
+					domain = "`+defaultCredentialDomain+`"
+				}`, randString, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_credential_azure_service_principal.foo", "id", "/tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("data.jenkins_credential_azure_service_principal.foo", "id", "/tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("data.jenkins_credential_azure_service_principal.foo", "name", "tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("data.jenkins_credential_azure_service_principal.foo", "description", "Terraform acceptance tests "+randString),
+					resource.TestCheckResourceAttr("data.jenkins_credential_azure_service_principal.foo", "subscription_id", "123"),
+					resource.TestCheckResourceAttr("data.jenkins_credential_azure_service_principal.foo", "client_id", "abc"),
+					resource.TestCheckResourceAttr("data.jenkins_credential_azure_service_principal.foo", "tenant", "xyz"),
+					resource.TestCheckResourceAttr("data.jenkins_credential_azure_service_principal.foo", "azure_environment_name", "Azure"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJenkinsCredentialAzureServicePrincipalDataSource_nested(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_folder foo {
+					name = "tf-acc-test-%s"
+				}
+
+				resource jenkins_credential_azure_service_principal sub {
+					name = "subfolder"
+					folder = jenkins_folder.foo.id
+					description = "Terraform acceptance tests %s"
+					subscription_id = "123"
+					client_id = "abc"
+					client_secret = "super-secret"
+					tenant = "xyz"
+				}
+
+				data jenkins_credential_azure_service_principal sub {
+					name   = jenkins_credential_azure_service_principal.sub.name
+					domain = "`+defaultCredentialDomain+`"
+					folder = jenkins_credential_azure_service_principal.sub.folder
+				}`, randString, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_folder.foo", "id", "/job/tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("jenkins_credential_azure_service_principal.sub", "id", "/job/tf-acc-test-"+randString+"/subfolder"),
+					resource.TestCheckResourceAttr("data.jenkins_credential_azure_service_principal.sub", "name", "subfolder"),
+					resource.TestCheckResourceAttr("data.jenkins_credential_azure_service_principal.sub", "folder", "/job/tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("data.jenkins_credential_azure_service_principal.sub", "description", "Terraform acceptance tests "+randString),
+					resource.TestCheckResourceAttr("data.jenkins_credential_azure_service_principal.sub", "subscription_id", "123"),
+					resource.TestCheckResourceAttr("data.jenkins_credential_azure_service_principal.sub", "client_id", "abc"),
+					resource.TestCheckResourceAttr("data.jenkins_credential_azure_service_principal.sub", "tenant", "xyz"),
+					resource.TestCheckResourceAttr("data.jenkins_credential_azure_service_principal.sub", "azure_environment_name", "Azure"),
+				),
+			},
+		},
+	})
+}
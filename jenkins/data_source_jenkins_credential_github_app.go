@@ -0,0 +1,99 @@
+package jenkins
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type credentialGitHubAppDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Folder      types.String `tfsdk:"folder"`
+	Description types.String `tfsdk:"description"`
+	Domain      types.String `tfsdk:"domain"`
+	Scope       types.String `tfsdk:"scope"`
+	AppID       types.String `tfsdk:"app_id"`
+	APIURI      types.String `tfsdk:"api_uri"`
+	Owner       types.String `tfsdk:"owner"`
+}
+
+type credentialGitHubAppDataSource struct {
+	*dataSourceHelper
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSourceWithConfigure = &credentialGitHubAppDataSource{}
+
+func newCredentialGitHubAppDataSource() datasource.DataSource {
+	return &credentialGitHubAppDataSource{
+		dataSourceHelper: newDataSourceHelper(),
+	}
+}
+
+func (d *credentialGitHubAppDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_github_app"
+}
+
+func (d *credentialGitHubAppDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the attributes of a GitHub App credential within Jenkins.",
+		Attributes: d.schemaCredential(map[string]schema.Attribute{
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the GitHub App.",
+				Computed:            true,
+			},
+			"api_uri": schema.StringAttribute{
+				MarkdownDescription: "The GitHub API endpoint the app authenticates against.",
+				Computed:            true,
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "The organization or user that the GitHub App is installed to.",
+				Computed:            true,
+			},
+		}),
+	}
+}
+
+func (d *credentialGitHubAppDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data credentialGitHubAppDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cm := d.client.Credentials()
+	cm.Folder = formatFolderName(data.Folder.ValueString())
+
+	if data.Domain.IsNull() {
+		data.Domain = basetypes.NewStringValue(defaultCredentialDomain)
+	}
+
+	cred := GitHubAppCredentials{}
+	err := cm.GetSingle(ctx, data.Domain.ValueString(), data.Name.ValueString(), &cred)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while parsing the data source read response. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), cred.ID))
+	data.Scope = types.StringValue(cred.Scope)
+	data.Description = types.StringValue(cred.Description)
+	data.AppID = types.StringValue(cred.AppID)
+	data.APIURI = types.StringValue(cred.APIURI)
+	data.Owner = types.StringValue(cred.Owner)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
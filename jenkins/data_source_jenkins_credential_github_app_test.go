@@ -0,0 +1,45 @@
+package jenkins
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJenkinsCredentialGitHubAppDataSource_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	privateKey := testAccPKCS8PrivateKey(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_credential_github_app foo {
+					name = "tf-acc-test-%s"
+					description = "Terraform acceptance tests %s"
+					app_id = "123456"
+					owner = "example"
+					private_key = <<EOT
+%sEOT
+				}
+
+				data jenkins_credential_github_app foo {
+					name   = jenkins_credential_github_app.foo.name
+					domain = "`+defaultCredentialDomain+`"
+				}`, randString, randString, privateKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_credential_github_app.foo", "id", "/tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("data.jenkins_credential_github_app.foo", "id", "/tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("data.jenkins_credential_github_app.foo", "name", "tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("data.jenkins_credential_github_app.foo", "description", "Terraform acceptance tests "+randString),
+					resource.TestCheckResourceAttr("data.jenkins_credential_github_app.foo", "app_id", "123456"),
+					resource.TestCheckResourceAttr("data.jenkins_credential_github_app.foo", "owner", "example"),
+				),
+			},
+		},
+	})
+}
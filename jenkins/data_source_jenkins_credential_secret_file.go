@@ -0,0 +1,88 @@
+package jenkins
+
+import (
+	"context"
+
+	jenkins "github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type credentialSecretFileDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Folder      types.String `tfsdk:"folder"`
+	Description types.String `tfsdk:"description"`
+	Domain      types.String `tfsdk:"domain"`
+	Scope       types.String `tfsdk:"scope"`
+	Filename    types.String `tfsdk:"filename"`
+}
+
+type credentialSecretFileDataSource struct {
+	*dataSourceHelper
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSourceWithConfigure = &credentialSecretFileDataSource{}
+
+func newCredentialSecretFileDataSource() datasource.DataSource {
+	return &credentialSecretFileDataSource{
+		dataSourceHelper: newDataSourceHelper(),
+	}
+}
+
+func (d *credentialSecretFileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_secret_file"
+}
+
+func (d *credentialSecretFileDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the attributes of a secret file credential within Jenkins.",
+		Attributes: d.schemaCredential(map[string]schema.Attribute{
+			"filename": schema.StringAttribute{
+				MarkdownDescription: "The name of the secret file.",
+				Computed:            true,
+			},
+		}),
+	}
+}
+
+func (d *credentialSecretFileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data credentialSecretFileDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cm := d.client.Credentials()
+	cm.Folder = formatFolderName(data.Folder.ValueString())
+
+	if data.Domain.IsNull() {
+		data.Domain = basetypes.NewStringValue(defaultCredentialDomain)
+	}
+
+	cred := jenkins.FileCredentials{}
+	err := cm.GetSingle(ctx, data.Domain.ValueString(), data.Name.ValueString(), &cred)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while parsing the data source read response. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), cred.ID))
+	data.Scope = types.StringValue(cred.Scope)
+	data.Description = types.StringValue(cred.Description)
+	data.Filename = types.StringValue(cred.Filename)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,80 @@
+package jenkins
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJenkinsCredentialSecretFileDataSource_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_credential_secret_file foo {
+				  name = "tf-acc-test-%s"
+				  description = "Terraform acceptance tests %s"
+				  filename = "secret.txt"
+				  secretbytes = base64encode("bar")
+				}
+
+				data jenkins_credential_secret_file foo {
+					name   = jenkins_credential_secret_file.foo.name
+					domain = "`+defaultCredentialDomain+`"
+				}`, randString, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_credential_secret_file.foo", "id", "/tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("data.jenkins_credential_secret_file.foo", "id", "/tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("data.jenkins_credential_secret_file.foo", "name", "tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("data.jenkins_credential_secret_file.foo", "description", "Terraform acceptance tests "+randString),
+					resource.TestCheckResourceAttr("data.jenkins_credential_secret_file.foo", "filename", "secret.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJenkinsCredentialSecretFileDataSource_nested(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_folder foo {
+					name = "tf-acc-test-%s"
+				}
+
+				resource jenkins_credential_secret_file sub {
+					name = "subfolder"
+					folder = jenkins_folder.foo.id
+					description = "Terraform acceptance tests %s"
+					filename = "secret.txt"
+					secretbytes = base64encode("bar")
+				}
+
+				data jenkins_credential_secret_file sub {
+					name   = jenkins_credential_secret_file.sub.name
+					domain = "`+defaultCredentialDomain+`"
+					folder = jenkins_credential_secret_file.sub.folder
+				}`, randString, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_folder.foo", "id", "/job/tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("jenkins_credential_secret_file.sub", "id", "/job/tf-acc-test-"+randString+"/subfolder"),
+					resource.TestCheckResourceAttr("data.jenkins_credential_secret_file.sub", "name", "subfolder"),
+					resource.TestCheckResourceAttr("data.jenkins_credential_secret_file.sub", "folder", "/job/tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("data.jenkins_credential_secret_file.sub", "description", "Terraform acceptance tests "+randString),
+					resource.TestCheckResourceAttr("data.jenkins_credential_secret_file.sub", "filename", "secret.txt"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,128 @@
+package jenkins
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type credentialUsageDataSourceModel struct {
+	ID     types.String                       `tfsdk:"id"`
+	Name   types.String                       `tfsdk:"name"`
+	Folder types.String                       `tfsdk:"folder"`
+	Domain types.String                       `tfsdk:"domain"`
+	InUse  types.Bool                         `tfsdk:"in_use"`
+	Usage  []credentialUsageDataSourceElement `tfsdk:"usage"`
+}
+
+type credentialUsageDataSourceElement struct {
+	Job        types.String `tfsdk:"job"`
+	FirstBuild types.Int64  `tfsdk:"first_build"`
+	LastBuild  types.Int64  `tfsdk:"last_build"`
+	BuildCount types.Int64  `tfsdk:"build_count"`
+}
+
+type credentialUsageDataSource struct {
+	*dataSourceHelper
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSourceWithConfigure = &credentialUsageDataSource{}
+
+func newCredentialUsageDataSource() datasource.DataSource {
+	return &credentialUsageDataSource{
+		dataSourceHelper: newDataSourceHelper(),
+	}
+}
+
+func (d *credentialUsageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_usage"
+}
+
+func (d *credentialUsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Get the jobs and builds that used a credential within Jenkins, as tracked by its fingerprint.
+
+~> Jenkins only records usage once a build resolves the credentials, and forgets the usage of builds that have been deleted. Credentials referenced by a job that has not yet run will not be reported.`,
+		Attributes: d.schema(map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain store containing this resource.",
+				Optional:            true,
+			},
+			"in_use": schema.BoolAttribute{
+				MarkdownDescription: "Whether any build has used the credentials.",
+				Computed:            true,
+			},
+			"usage": schema.ListNestedAttribute{
+				MarkdownDescription: "The jobs that used the credentials.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"job": schema.StringAttribute{
+							MarkdownDescription: "The full canonical job path, e.g. `/job/job-name`.",
+							Computed:            true,
+						},
+						"first_build": schema.Int64Attribute{
+							MarkdownDescription: "The number of the earliest build of the job that used the credentials.",
+							Computed:            true,
+						},
+						"last_build": schema.Int64Attribute{
+							MarkdownDescription: "The number of the latest build of the job that used the credentials.",
+							Computed:            true,
+						},
+						"build_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of builds of the job that used the credentials.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func (d *credentialUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data credentialUsageDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Domain.IsNull() {
+		data.Domain = basetypes.NewStringValue(defaultCredentialDomain)
+	}
+
+	usage, err := d.client.GetCredentialUsage(ctx, data.Folder.ValueString(), data.Domain.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while parsing the data source read response. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	data.Usage = []credentialUsageDataSourceElement{}
+	for _, item := range usage {
+		data.Usage = append(data.Usage, credentialUsageDataSourceElement{
+			Job:        types.StringValue(item.Job),
+			FirstBuild: types.Int64Value(item.FirstBuild),
+			LastBuild:  types.Int64Value(item.LastBuild),
+			BuildCount: types.Int64Value(item.BuildCount),
+		})
+	}
+
+	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), data.Name.ValueString()))
+	data.InUse = types.BoolValue(len(data.Usage) > 0)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,36 @@
+package jenkins
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJenkinsCredentialUsageDataSource_unused(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_credential_secret_text foo {
+				  name = "tf-acc-test-%s"
+				  secret = "bar"
+				}
+
+				data jenkins_credential_usage foo {
+					name = jenkins_credential_secret_text.foo.name
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jenkins_credential_usage.foo", "id", "/tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("data.jenkins_credential_usage.foo", "in_use", "false"),
+					resource.TestCheckResourceAttr("data.jenkins_credential_usage.foo", "usage.#", "0"),
+				),
+			},
+		},
+	})
+}
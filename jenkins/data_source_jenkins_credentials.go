@@ -0,0 +1,165 @@
+package jenkins
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type credentialsDataSourceModel struct {
+	ID          types.String                   `tfsdk:"id"`
+	Folder      types.String                   `tfsdk:"folder"`
+	Domain      types.String                   `tfsdk:"domain"`
+	Type        types.String                   `tfsdk:"type"`
+	IDRegex     types.String                   `tfsdk:"id_regex"`
+	Credentials []credentialsDataSourceElement `tfsdk:"credentials"`
+}
+
+type credentialsDataSourceElement struct {
+	ID          types.String `tfsdk:"id"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+	Scope       types.String `tfsdk:"scope"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+}
+
+type credentialsDataSource struct {
+	*dataSourceHelper
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSourceWithConfigure = &credentialsDataSource{}
+
+func newCredentialsDataSource() datasource.DataSource {
+	return &credentialsDataSource{
+		dataSourceHelper: newDataSourceHelper(),
+	}
+}
+
+func (d *credentialsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credentials"
+}
+
+func (d *credentialsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+List the credentials stored within a domain of a folder or the system credentials store. Secrets are never returned.
+
+~> The type and scope of the credentials are read from the configuration of each credential, which takes a request per listed credential. Use "id_regex" to limit the credentials that are read in large domains. The credentials used by the provider must be allowed to read the "config.xml" of each listed credential, in addition to listing them.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The full canonical domain path, e.g. `/job/folder-name/_`.",
+				Computed:            true,
+			},
+			"folder": schema.StringAttribute{
+				MarkdownDescription: "The folder namespace containing the credentials. If not set then the system credentials store is listed.",
+				Optional:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain store containing the credentials. Defaults to the global domain.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return credentials of the given class, e.g. `com.cloudbees.plugins.credentials.impl.UsernamePasswordCredentialsImpl`.",
+				Optional:            true,
+			},
+			"id_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return credentials with an ID matching the given regular expression.",
+				Optional:            true,
+			},
+			"credentials": schema.ListNestedAttribute{
+				MarkdownDescription: "The credentials stored within the domain.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The identifier of the credentials, as referenced by jobs.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The class of the credentials.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "A human readable description of the credentials.",
+							Computed:            true,
+						},
+						"scope": schema.StringAttribute{
+							MarkdownDescription: `The visibility of the credentials to Jenkins agents. This will be either "GLOBAL" or "SYSTEM".`,
+							Computed:            true,
+						},
+						"fingerprint": schema.StringAttribute{
+							MarkdownDescription: "The fingerprint Jenkins uses to track usage of the credentials. This is empty until the credentials have been used by a build.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *credentialsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data credentialsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Domain.IsNull() {
+		data.Domain = basetypes.NewStringValue(defaultCredentialDomain)
+	}
+
+	var idRegex *regexp.Regexp
+	if !data.IDRegex.IsNull() {
+		var err error
+		idRegex, err = regexp.Compile(data.IDRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id_regex"),
+				"Invalid Regular Expression",
+				"The id_regex attribute must be a valid regular expression.\n\n"+
+					"Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	creds, err := d.client.ListCredentials(ctx, data.Folder.ValueString(), data.Domain.ValueString(), idRegex)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while parsing the data source read response. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	data.Credentials = []credentialsDataSourceElement{}
+	for _, cred := range creds {
+		if !data.Type.IsNull() && cred.Type != data.Type.ValueString() {
+			continue
+		}
+		data.Credentials = append(data.Credentials, credentialsDataSourceElement{
+			ID:          types.StringValue(cred.ID),
+			Type:        types.StringValue(cred.Type),
+			Description: types.StringValue(cred.Description),
+			Scope:       types.StringValue(cred.Scope),
+			Fingerprint: types.StringValue(cred.Fingerprint),
+		})
+	}
+
+	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), data.Domain.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
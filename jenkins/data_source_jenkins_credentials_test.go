@@ -0,0 +1,70 @@
+package jenkins
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJenkinsCredentialsDataSource_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_folder foo {
+					name = "tf-acc-test-%s"
+				}
+
+				resource jenkins_credential_username foo {
+					name = "username"
+					folder = jenkins_folder.foo.id
+					description = "Terraform acceptance tests %s"
+					username = "foo"
+					password = "bar"
+				}
+
+				resource jenkins_credential_secret_text foo {
+					name = "secret-text"
+					folder = jenkins_folder.foo.id
+					scope = "SYSTEM"
+					secret = "bar"
+				}
+
+				data jenkins_credentials all {
+					folder = jenkins_folder.foo.id
+					depends_on = [jenkins_credential_username.foo, jenkins_credential_secret_text.foo]
+				}
+
+				data jenkins_credentials type {
+					folder = jenkins_folder.foo.id
+					type = "org.jenkinsci.plugins.plaincredentials.impl.StringCredentialsImpl"
+					depends_on = [jenkins_credential_username.foo, jenkins_credential_secret_text.foo]
+				}
+
+				data jenkins_credentials regex {
+					folder = jenkins_folder.foo.id
+					id_regex = "^user"
+					depends_on = [jenkins_credential_username.foo, jenkins_credential_secret_text.foo]
+				}`, randString, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jenkins_credentials.all", "id", "/job/tf-acc-test-"+randString+"/_"),
+					resource.TestCheckResourceAttr("data.jenkins_credentials.all", "credentials.#", "2"),
+					resource.TestCheckResourceAttr("data.jenkins_credentials.type", "credentials.#", "1"),
+					resource.TestCheckResourceAttr("data.jenkins_credentials.type", "credentials.0.id", "secret-text"),
+					resource.TestCheckResourceAttr("data.jenkins_credentials.type", "credentials.0.scope", "SYSTEM"),
+					resource.TestCheckResourceAttr("data.jenkins_credentials.regex", "credentials.#", "1"),
+					resource.TestCheckResourceAttr("data.jenkins_credentials.regex", "credentials.0.id", "username"),
+					resource.TestCheckResourceAttr("data.jenkins_credentials.regex", "credentials.0.type", "com.cloudbees.plugins.credentials.impl.UsernamePasswordCredentialsImpl"),
+					resource.TestCheckResourceAttr("data.jenkins_credentials.regex", "credentials.0.description", "Terraform acceptance tests "+randString),
+					resource.TestCheckResourceAttr("data.jenkins_credentials.regex", "credentials.0.scope", "GLOBAL"),
+				),
+			},
+		},
+	})
+}
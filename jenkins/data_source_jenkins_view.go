@@ -39,7 +39,7 @@ func (d *ViewDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 		Attributes: d.schema(map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "The unique name of this view.",
+				MarkdownDescription: "The unique name of this view. Views within folders are identified by their path, e.g. `/job/folder-name/view/view-name`.",
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "A human readable description of the view.",
@@ -62,7 +62,7 @@ func (d *ViewDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	view, err := d.client.GetView(ctx, data.Name.ValueString())
+	view, err := d.client.GetViewInFolder(ctx, data.Folder.ValueString(), data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Data Source",
@@ -74,7 +74,7 @@ func (d *ViewDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	data.ID = types.StringValue(view.GetName())
+	data.ID = types.StringValue(viewID(data.Folder.ValueString(), view.GetName()))
 	data.Name = types.StringValue(view.GetName())
 	data.Description = types.StringValue(view.GetDescription())
 	data.URL = types.StringValue(view.GetUrl())
@@ -3,21 +3,31 @@ package jenkins
 import (
 	"encoding/xml"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
 )
 
 type folder struct {
-	XMLName       xml.Name         `xml:"com.cloudbees.hudson.plugins.folder.Folder"`
-	Description   string           `xml:"description"`
-	DisplayName   string           `xml:"displayName,omitempty"`
-	Properties    folderProperties `xml:"properties"`
-	FolderViews   xmlRawProperty   `xml:"folderViews"`
-	HealthMetrics xmlRawProperty   `xml:"healthMetrics"`
+	XMLName       xml.Name            `xml:"com.cloudbees.hudson.plugins.folder.Folder"`
+	Plugin        string              `xml:"plugin,attr,omitempty"`
+	Description   string              `xml:"description"`
+	DisplayName   string              `xml:"displayName,omitempty"`
+	Properties    folderProperties    `xml:"properties"`
+	FolderViews   folderViews         `xml:"folderViews"`
+	HealthMetrics folderHealthMetrics `xml:"healthMetrics"`
+	Other         []xmlRawProperty    `xml:",any"`
 }
 
 type folderProperties struct {
-	Security *folderSecurity  `xml:"com.cloudbees.hudson.plugins.folder.properties.AuthorizationMatrixProperty,omitempty"`
-	Other    []xmlRawProperty `xml:",any"`
+	Security   *folderSecurity    `xml:"com.cloudbees.hudson.plugins.folder.properties.AuthorizationMatrixProperty,omitempty"`
+	EnvVars    *folderEnvVars     `xml:"com.cloudbees.hudson.plugins.folder.properties.EnvVarsFolderProperty,omitempty"`
+	Libraries  *pipelineLibraries `xml:"org.jenkinsci.plugins.workflow.libs.FolderLibraries,omitempty"`
+	Docker     *folderDocker      `xml:"org.jenkinsci.plugins.docker.workflow.declarative.FolderConfig,omitempty"`
+	Kubernetes *folderKubernetes  `xml:"org.csanchez.jenkins.plugins.kubernetes.KubernetesFolderProperty,omitempty"`
+	Other      []xmlRawProperty   `xml:",any"`
 }
 
 type folderSecurity struct {
@@ -29,10 +39,121 @@ type folderPermissionInheritanceStrategy struct {
 	Class string `xml:"class,attr"`
 }
 
+// folderPermissionTypes are the principal types that may prefix a permission entry.
+// Entries without a prefix are ambiguous and are matched against both users and groups.
+var folderPermissionTypes = []string{"USER", "GROUP"}
+
+// folderPermissionCatalog maps the short permission names, as used by the Jenkins
+// Configuration as Code plugin, to the permission IDs stored in the folder configuration.
+var folderPermissionCatalog = map[string]string{
+	"Credentials/Create":        "com.cloudbees.plugins.credentials.CredentialsProvider.Create",
+	"Credentials/Delete":        "com.cloudbees.plugins.credentials.CredentialsProvider.Delete",
+	"Credentials/ManageDomains": "com.cloudbees.plugins.credentials.CredentialsProvider.ManageDomains",
+	"Credentials/Update":        "com.cloudbees.plugins.credentials.CredentialsProvider.Update",
+	"Credentials/View":          "com.cloudbees.plugins.credentials.CredentialsProvider.View",
+	"Job/Build":                 "hudson.model.Item.Build",
+	"Job/Cancel":                "hudson.model.Item.Cancel",
+	"Job/Configure":             "hudson.model.Item.Configure",
+	"Job/Create":                "hudson.model.Item.Create",
+	"Job/Delete":                "hudson.model.Item.Delete",
+	"Job/Discover":              "hudson.model.Item.Discover",
+	"Job/ExtendedRead":          "hudson.model.Item.ExtendedRead",
+	"Job/Move":                  "hudson.model.Item.Move",
+	"Job/Read":                  "hudson.model.Item.Read",
+	"Job/Workspace":             "hudson.model.Item.Workspace",
+	"Run/Delete":                "hudson.model.Run.Delete",
+	"Run/Replay":                "hudson.model.Run.Replay",
+	"Run/Update":                "hudson.model.Run.Update",
+	"SCM/Tag":                   "hudson.scm.SCM.Tag",
+	"View/Configure":            "hudson.model.View.Configure",
+	"View/Create":               "hudson.model.View.Create",
+	"View/Delete":               "hudson.model.View.Delete",
+	"View/Read":                 "hudson.model.View.Read",
+}
+
+// folderPermission is a single entry of the folder authorization matrix, such as
+// "USER:hudson.model.Item.Read:alice".
+type folderPermission struct {
+	Type       string
+	Permission string
+	Principal  string
+}
+
+func parseFolderPermission(entry string) folderPermission {
+	ret := folderPermission{}
+	for _, t := range folderPermissionTypes {
+		if strings.HasPrefix(entry, t+":") {
+			ret.Type = t
+			entry = strings.TrimPrefix(entry, t+":")
+			break
+		}
+	}
+
+	ret.Permission, ret.Principal, _ = strings.Cut(entry, ":")
+	return ret
+}
+
+func (p folderPermission) String() string {
+	ret := p.Permission + ":" + p.Principal
+	if p.Type != "" {
+		ret = p.Type + ":" + ret
+	}
+	return ret
+}
+
+// shortName returns the catalog name of the permission, or an empty string if the
+// permission is not part of the catalog.
+func (p folderPermission) shortName() string {
+	for name, id := range folderPermissionCatalog {
+		if id == p.Permission {
+			return name
+		}
+	}
+	return ""
+}
+
+// folderEnvVars stores environment variables in the Java properties file format,
+// one "KEY=value" pair per line.
+type folderEnvVars struct {
+	Plugin     string `xml:"plugin,attr,omitempty"`
+	Properties string `xml:"properties"`
+}
+
+type folderDocker struct {
+	Plugin      string           `xml:"plugin,attr,omitempty"`
+	DockerLabel string           `xml:"dockerLabel"`
+	Other       []xmlRawProperty `xml:",any"`
+}
+
+type folderKubernetes struct {
+	Plugin          string   `xml:"plugin,attr,omitempty"`
+	PermittedClouds []string `xml:"permittedClouds>string"`
+}
+
+// folderViews holds the views of a folder. The views themselves are retained as-is so
+// that views created through the UI are not lost when the folder is updated.
+type folderViews struct {
+	Class       string           `xml:"class,attr,omitempty"`
+	Views       *xmlRawProperty  `xml:"views,omitempty"`
+	PrimaryView string           `xml:"primaryView,omitempty"`
+	Other       []xmlRawProperty `xml:",any"`
+}
+
+type folderHealthMetrics struct {
+	WorstChild *folderWorstChildHealthMetric `xml:"com.cloudbees.hudson.plugins.folder.health.WorstChildHealthMetric,omitempty"`
+	Other      []xmlRawProperty              `xml:",any"`
+}
+
+type folderWorstChildHealthMetric struct {
+	Plugin       string `xml:"plugin,attr,omitempty"`
+	NonRecursive bool   `xml:"nonRecursive"`
+}
+
 type xmlRawProperty struct {
 	XMLName xml.Name
-	Plugin  string `xml:"plugin,attr,omitempty"`
-	Raw     string `xml:",innerxml"`
+	Plugin  string     `xml:"plugin,attr,omitempty"`
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Raw     string     `xml:",innerxml"`
 }
 
 func parseFolder(config string) (*folder, error) {
@@ -50,6 +171,198 @@ func (j *folder) Render() ([]byte, error) {
 	return xml.MarshalIndent(j, "", "\t")
 }
 
+// parseEnvVars converts the properties file format used by EnvVarsFolderProperty into a map.
+// Lines are parsed in the same way as java.util.Properties, which is what Jenkins uses to
+// load them.
+func (e *folderEnvVars) parseEnvVars() map[string]string {
+	ret := map[string]string{}
+	if e == nil {
+		return ret
+	}
+
+	for _, line := range propertiesLines(e.Properties) {
+		key, value := splitProperty(line)
+		ret[unescapeProperty(key)] = unescapeProperty(value)
+	}
+
+	return ret
+}
+
+// renderEnvVars converts a map of environment variables into an EnvVarsFolderProperty.
+// Keys are sorted so that the rendered output is stable between runs.
+func renderEnvVars(vars map[string]string) *folderEnvVars {
+	if len(vars) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(vars))
+	for key := range vars {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	lines := make([]string, 0, len(keys))
+	for _, key := range keys {
+		lines = append(lines, escapeProperty(key, true)+"="+escapeProperty(vars[key], false))
+	}
+
+	return &folderEnvVars{Properties: strings.Join(lines, "\n")}
+}
+
+// propertiesLines returns the logical lines of a properties file, joining lines that end
+// with an odd number of backslashes and dropping blank lines and comments.
+func propertiesLines(props string) []string {
+	props = strings.ReplaceAll(props, "\r\n", "\n")
+	props = strings.ReplaceAll(props, "\r", "\n")
+
+	ret := []string{}
+	logical := ""
+	continued := false
+	for _, line := range strings.Split(props, "\n") {
+		line = strings.TrimLeft(line, " \t\f")
+		if !continued && (line == "" || line[0] == '#' || line[0] == '!') {
+			continue
+		}
+
+		backslashes := len(line) - len(strings.TrimRight(line, "\\"))
+		continued = backslashes%2 == 1
+		if continued {
+			line = line[:len(line)-1]
+		}
+
+		logical += line
+		if !continued {
+			ret = append(ret, logical)
+			logical = ""
+		}
+	}
+	if logical != "" {
+		ret = append(ret, logical)
+	}
+
+	return ret
+}
+
+// splitProperty splits a logical properties line into its still escaped key and value. The
+// key ends at the first unescaped '=', ':' or whitespace.
+func splitProperty(line string) (key, value string) {
+	end := len(line)
+	for i := 0; i < len(line); i++ {
+		if line[i] == '\\' {
+			i++
+			continue
+		}
+		if strings.IndexByte("=: \t\f", line[i]) >= 0 {
+			end = i
+			break
+		}
+	}
+
+	value = strings.TrimLeft(line[end:], " \t\f")
+	if value != "" && (value[0] == '=' || value[0] == ':') {
+		value = strings.TrimLeft(value[1:], " \t\f")
+	}
+
+	return line[:end], value
+}
+
+// unescapeProperty resolves the escape sequences of a properties key or value.
+func unescapeProperty(s string) string {
+	if !strings.Contains(s, "\\") {
+		return s
+	}
+
+	var ret strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i == len(s)-1 {
+			ret.WriteByte(s[i])
+			continue
+		}
+
+		i++
+		switch s[i] {
+		case 't':
+			ret.WriteByte('\t')
+		case 'n':
+			ret.WriteByte('\n')
+		case 'r':
+			ret.WriteByte('\r')
+		case 'f':
+			ret.WriteByte('\f')
+		case 'u':
+			r, n := unescapeUnicode(s[i+1:])
+			ret.WriteRune(r)
+			i += n
+		default:
+			ret.WriteByte(s[i])
+		}
+	}
+
+	return ret.String()
+}
+
+// unescapeUnicode decodes the hex digits following a \u escape, combining surrogate pairs.
+// It returns the rune and the number of bytes consumed.
+func unescapeUnicode(s string) (rune, int) {
+	if len(s) < 4 {
+		return utf8.RuneError, len(s)
+	}
+
+	r, err := strconv.ParseUint(s[:4], 16, 16)
+	if err != nil {
+		return utf8.RuneError, 4
+	}
+
+	if utf16.IsSurrogate(rune(r)) && len(s) >= 10 && strings.HasPrefix(s[4:], "\\u") {
+		if low, err := strconv.ParseUint(s[6:10], 16, 16); err == nil {
+			if combined := utf16.DecodeRune(rune(r), rune(low)); combined != utf8.RuneError {
+				return combined, 10
+			}
+		}
+	}
+
+	return rune(r), 4
+}
+
+// escapeProperty escapes a properties key or value so that java.util.Properties reads it
+// back verbatim. Keys additionally escape the characters that would end them.
+func escapeProperty(s string, key bool) string {
+	var ret strings.Builder
+	for i, r := range s {
+		switch r {
+		case '\\':
+			ret.WriteString(`\\`)
+		case '\t':
+			ret.WriteString(`\t`)
+		case '\n':
+			ret.WriteString(`\n`)
+		case '\r':
+			ret.WriteString(`\r`)
+		case '\f':
+			ret.WriteString(`\f`)
+		case ' ':
+			if key || i == 0 {
+				ret.WriteByte('\\')
+			}
+			ret.WriteRune(r)
+		case '=', ':', '#', '!':
+			if key {
+				ret.WriteByte('\\')
+			}
+			ret.WriteRune(r)
+		default:
+			// Other control characters cannot be represented in the XML document
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&ret, `\u%04X`, r)
+				continue
+			}
+			ret.WriteRune(r)
+		}
+	}
+
+	return ret.String()
+}
+
 func handleXml(def string) []byte {
 	// This is a horrible practice...but Go doesn't seem to have any mature
 	// support for the XML 1.1 specification. As long as Jenkins doesn't make
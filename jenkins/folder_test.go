@@ -42,6 +42,22 @@ func Test_parseFolder(t *testing.T) {
         </org.jenkinsci.plugins.workflow.libs.LibraryConfiguration>
       </libraries>
     </org.jenkinsci.plugins.workflow.libs.FolderLibraries>
+    <com.cloudbees.hudson.plugins.folder.properties.EnvVarsFolderProperty plugin="cloudbees-folders-plus@3.10">
+      <properties>FOO=bar
+BAZ=qux</properties>
+    </com.cloudbees.hudson.plugins.folder.properties.EnvVarsFolderProperty>
+    <org.jenkinsci.plugins.docker.workflow.declarative.FolderConfig plugin="docker-workflow@1.26">
+      <dockerLabel>docker</dockerLabel>
+      <registry plugin="docker-commons@1.17"/>
+    </org.jenkinsci.plugins.docker.workflow.declarative.FolderConfig>
+    <org.csanchez.jenkins.plugins.kubernetes.KubernetesFolderProperty plugin="kubernetes@1.30.1">
+      <permittedClouds>
+        <string>kubernetes</string>
+      </permittedClouds>
+    </org.csanchez.jenkins.plugins.kubernetes.KubernetesFolderProperty>
+    <org.jenkinsci.plugins.pipeline.maven.MavenConfigFolderOverrideProperty plugin="pipeline-maven@3.10.0">
+      <override>false</override>
+    </org.jenkinsci.plugins.pipeline.maven.MavenConfigFolderOverrideProperty>
   </properties>
   <folderViews class="com.cloudbees.hudson.plugins.folder.views.DefaultFolderViewHolder">
     <views>
@@ -78,7 +94,7 @@ func Test_parseFolder(t *testing.T) {
     <tabBar class="hudson.views.DefaultViewsTabBar"/>
   </folderViews>
   <healthMetrics>
-    <com.cloudbees.hudson.plugins.folder.health.WorstChildHealthMetric>
+    <com.cloudbees.hudson.plugins.folder.health.WorstChildHealthMetric plugin="cloudbees-folder@6.15">
       <nonRecursive>true</nonRecursive>
     </com.cloudbees.hudson.plugins.folder.health.WorstChildHealthMetric>
   </healthMetrics>
@@ -87,6 +103,7 @@ func Test_parseFolder(t *testing.T) {
 			},
 			want: &folder{
 				XMLName:     xml.Name{Local: "com.cloudbees.hudson.plugins.folder.Folder"},
+				Plugin:      "cloudbees-folder@6.15",
 				Description: "Example Description",
 				DisplayName: "Example Display Name",
 				Properties: folderProperties{
@@ -101,27 +118,50 @@ func Test_parseFolder(t *testing.T) {
 							"hudson.model.Item.Discover:anonymous",
 						},
 					},
+					EnvVars: &folderEnvVars{
+						Plugin:     "cloudbees-folders-plus@3.10",
+						Properties: "FOO=bar\nBAZ=qux",
+					},
+					Libraries: &pipelineLibraries{
+						Plugin: "workflow-cps-global-lib@2.17",
+						Libraries: []pipelineLibrary{
+							{
+								Name:                 "Example Library Configuration",
+								Implicit:             false,
+								AllowVersionOverride: true,
+								IncludeInChangesets:  true,
+							},
+						},
+					},
+					Docker: &folderDocker{
+						Plugin:      "docker-workflow@1.26",
+						DockerLabel: "docker",
+						Other: []xmlRawProperty{
+							{
+								XMLName: xml.Name{Local: "registry"},
+								Plugin:  "docker-commons@1.17",
+							},
+						},
+					},
+					Kubernetes: &folderKubernetes{
+						Plugin:          "kubernetes@1.30.1",
+						PermittedClouds: []string{"kubernetes"},
+					},
 					Other: []xmlRawProperty{
 						{
-							XMLName: xml.Name{Local: "org.jenkinsci.plugins.workflow.libs.FolderLibraries"},
-							Plugin:  "workflow-cps-global-lib@2.17",
+							XMLName: xml.Name{Local: "org.jenkinsci.plugins.pipeline.maven.MavenConfigFolderOverrideProperty"},
+							Plugin:  "pipeline-maven@3.10.0",
 							Raw: `
-      <libraries>
-        <org.jenkinsci.plugins.workflow.libs.LibraryConfiguration>
-          <name>Example Library Configuration</name>
-          <implicit>false</implicit>
-          <allowVersionOverride>true</allowVersionOverride>
-          <includeInChangesets>true</includeInChangesets>
-        </org.jenkinsci.plugins.workflow.libs.LibraryConfiguration>
-      </libraries>
+      <override>false</override>
     `,
 						},
 					},
 				},
-				FolderViews: xmlRawProperty{
-					XMLName: xml.Name{Local: "folderViews"},
-					Raw: `
-    <views>
+				FolderViews: folderViews{
+					Class: "com.cloudbees.hudson.plugins.folder.views.DefaultFolderViewHolder",
+					Views: &xmlRawProperty{
+						XMLName: xml.Name{Local: "views"},
+						Raw: `
       <hudson.model.AllView>
         <owner class="com.cloudbees.hudson.plugins.folder.Folder" reference="../../../.."/>
         <name>All</name>
@@ -150,18 +190,34 @@ func Test_parseFolder(t *testing.T) {
         </columns>
         <recurse>false</recurse>
       </hudson.model.ListView>
-    </views>
-    <primaryView>All</primaryView>
-    <tabBar class="hudson.views.DefaultViewsTabBar"/>
-  `,
+    `,
+					},
+					PrimaryView: "All",
+					Other: []xmlRawProperty{
+						{
+							XMLName: xml.Name{Local: "tabBar"},
+							Attrs: []xml.Attr{
+								{Name: xml.Name{Local: "class"}, Value: "hudson.views.DefaultViewsTabBar"},
+							},
+						},
+					},
 				},
-				HealthMetrics: xmlRawProperty{
-					XMLName: xml.Name{Local: "healthMetrics"},
-					Raw: `
-    <com.cloudbees.hudson.plugins.folder.health.WorstChildHealthMetric>
-      <nonRecursive>true</nonRecursive>
-    </com.cloudbees.hudson.plugins.folder.health.WorstChildHealthMetric>
-  `,
+				HealthMetrics: folderHealthMetrics{
+					WorstChild: &folderWorstChildHealthMetric{
+						Plugin:       "cloudbees-folder@6.15",
+						NonRecursive: true,
+					},
+				},
+				Other: []xmlRawProperty{
+					{
+						XMLName: xml.Name{Local: "actions"},
+					},
+					{
+						XMLName: xml.Name{Local: "icon"},
+						Attrs: []xml.Attr{
+							{Name: xml.Name{Local: "class"}, Value: "com.cloudbees.hudson.plugins.folder.icons.StockFolderIcon"},
+						},
+					},
 				},
 			},
 		},
@@ -191,9 +247,11 @@ func Test_parseFolder(t *testing.T) {
 
 func Test_folder_Render(t *testing.T) {
 	type fields struct {
-		Description string
-		DisplayName string
-		Properties  folderProperties
+		Description   string
+		DisplayName   string
+		Properties    folderProperties
+		FolderViews   folderViews
+		HealthMetrics folderHealthMetrics
 	}
 	tests := []struct {
 		name    string
@@ -213,19 +271,28 @@ func Test_folder_Render(t *testing.T) {
 							Class: "org.jenkinsci.plugins.matrixauth.inheritance.InheritParentStrategy",
 						},
 					},
+					EnvVars: &folderEnvVars{
+						Properties: "FOO=bar",
+					},
+					Libraries: &pipelineLibraries{
+						Plugin: "workflow-cps-global-lib@2.17",
+						Libraries: []pipelineLibrary{
+							{
+								Name:                 "Example Library Configuration",
+								AllowVersionOverride: true,
+								IncludeInChangesets:  true,
+							},
+						},
+					},
+					Kubernetes: &folderKubernetes{
+						PermittedClouds: []string{"kubernetes"},
+					},
 					Other: []xmlRawProperty{
 						{
-							XMLName: xml.Name{Local: "org.jenkinsci.plugins.workflow.libs.FolderLibraries"},
-							Plugin:  "workflow-cps-global-lib@2.17",
+							XMLName: xml.Name{Local: "org.jenkinsci.plugins.pipeline.maven.MavenConfigFolderOverrideProperty"},
+							Plugin:  "pipeline-maven@3.10.0",
 							Raw: `
-      <libraries>
-        <org.jenkinsci.plugins.workflow.libs.LibraryConfiguration>
-          <name>Example Library Configuration</name>
-          <implicit>false</implicit>
-          <allowVersionOverride>true</allowVersionOverride>
-          <includeInChangesets>true</includeInChangesets>
-        </org.jenkinsci.plugins.workflow.libs.LibraryConfiguration>
-      </libraries>
+      <override>false</override>
     `,
 						},
 					},
@@ -240,6 +307,9 @@ func Test_folder_Render(t *testing.T) {
 			<permission>example</permission>
 			<permission>permission</permission>
     </com.cloudbees.hudson.plugins.folder.properties.AuthorizationMatrixProperty>
+    <com.cloudbees.hudson.plugins.folder.properties.EnvVarsFolderProperty>
+      <properties>FOO=bar</properties>
+    </com.cloudbees.hudson.plugins.folder.properties.EnvVarsFolderProperty>
     <org.jenkinsci.plugins.workflow.libs.FolderLibraries plugin="workflow-cps-global-lib@2.17">
       <libraries>
         <org.jenkinsci.plugins.workflow.libs.LibraryConfiguration>
@@ -250,18 +320,70 @@ func Test_folder_Render(t *testing.T) {
         </org.jenkinsci.plugins.workflow.libs.LibraryConfiguration>
       </libraries>
     </org.jenkinsci.plugins.workflow.libs.FolderLibraries>
+    <org.csanchez.jenkins.plugins.kubernetes.KubernetesFolderProperty>
+      <permittedClouds>
+        <string>kubernetes</string>
+      </permittedClouds>
+    </org.csanchez.jenkins.plugins.kubernetes.KubernetesFolderProperty>
+    <org.jenkinsci.plugins.pipeline.maven.MavenConfigFolderOverrideProperty plugin="pipeline-maven@3.10.0">
+      <override>false</override>
+    </org.jenkinsci.plugins.pipeline.maven.MavenConfigFolderOverrideProperty>
 	</properties>
 	<folderViews></folderViews>
 	<healthMetrics></healthMetrics>
+</com.cloudbees.hudson.plugins.folder.Folder>`),
+		},
+		{
+			name: "views",
+			fields: fields{
+				Description: "Example Description",
+				FolderViews: folderViews{
+					Class: "com.cloudbees.hudson.plugins.folder.views.DefaultFolderViewHolder",
+					Views: &xmlRawProperty{
+						XMLName: xml.Name{Local: "views"},
+						Raw:     `<hudson.model.AllView><name>All</name></hudson.model.AllView>`,
+					},
+					PrimaryView: "All",
+					Other: []xmlRawProperty{
+						{
+							XMLName: xml.Name{Local: "tabBar"},
+							Attrs: []xml.Attr{
+								{Name: xml.Name{Local: "class"}, Value: "hudson.views.DefaultViewsTabBar"},
+							},
+						},
+					},
+				},
+				HealthMetrics: folderHealthMetrics{
+					WorstChild: &folderWorstChildHealthMetric{
+						Plugin:       "cloudbees-folder@6.15",
+						NonRecursive: false,
+					},
+				},
+			},
+			want: []byte(`<com.cloudbees.hudson.plugins.folder.Folder>
+	<description>Example Description</description>
+	<properties></properties>
+	<folderViews class="com.cloudbees.hudson.plugins.folder.views.DefaultFolderViewHolder">
+		<views><hudson.model.AllView><name>All</name></hudson.model.AllView></views>
+		<primaryView>All</primaryView>
+		<tabBar class="hudson.views.DefaultViewsTabBar"></tabBar>
+	</folderViews>
+	<healthMetrics>
+		<com.cloudbees.hudson.plugins.folder.health.WorstChildHealthMetric plugin="cloudbees-folder@6.15">
+			<nonRecursive>false</nonRecursive>
+		</com.cloudbees.hudson.plugins.folder.health.WorstChildHealthMetric>
+	</healthMetrics>
 </com.cloudbees.hudson.plugins.folder.Folder>`),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			j := &folder{
-				Description: tt.fields.Description,
-				DisplayName: tt.fields.DisplayName,
-				Properties:  tt.fields.Properties,
+				Description:   tt.fields.Description,
+				DisplayName:   tt.fields.DisplayName,
+				Properties:    tt.fields.Properties,
+				FolderViews:   tt.fields.FolderViews,
+				HealthMetrics: tt.fields.HealthMetrics,
 			}
 			got, err := j.Render()
 			if (err != nil) != tt.wantErr {
@@ -282,3 +404,151 @@ func Test_folder_Render(t *testing.T) {
 		})
 	}
 }
+
+func Test_folderEnvVars(t *testing.T) {
+	tests := []struct {
+		name string
+		vars map[string]string
+		want *folderEnvVars
+	}{
+		{
+			name: "empty",
+			vars: map[string]string{},
+			want: nil,
+		},
+		{
+			name: "sorted",
+			vars: map[string]string{
+				"FOO": "bar",
+				"BAZ": "a=b",
+			},
+			want: &folderEnvVars{Properties: "BAZ=a=b\nFOO=bar"},
+		},
+		{
+			name: "escaped-keys",
+			vars: map[string]string{
+				"A=B":     "1",
+				"C:D":     "2",
+				"E F":     "3",
+				"#G":      "4",
+				`H\I`:     "5",
+				"J\tK\nL": "6",
+			},
+			want: &folderEnvVars{Properties: "\\#G=4\nA\\=B=1\nC\\:D=2\nE\\ F=3\nH\\\\I=5\nJ\\tK\\nL=6"},
+		},
+		{
+			name: "escaped-values",
+			vars: map[string]string{
+				"MULTILINE": "first\nsecond\r\nthird",
+				"PATH":      `C:\Program Files\tool`,
+				"PADDED":    "  padded ",
+				"CONTROL":   "\x00bell\x07",
+			},
+			want: &folderEnvVars{Properties: `CONTROL=\u0000bell\u0007` + "\n" +
+				`MULTILINE=first\nsecond\r\nthird` + "\n" +
+				`PADDED=\  padded ` + "\n" +
+				`PATH=C:\\Program Files\\tool`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := renderEnvVars(tt.vars)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("renderEnvVars() = %#v, want %#v", got, tt.want)
+			}
+
+			if parsed := got.parseEnvVars(); !reflect.DeepEqual(parsed, tt.vars) {
+				t.Errorf("parseEnvVars() = %#v, want %#v", parsed, tt.vars)
+			}
+		})
+	}
+}
+
+func Test_folderEnvVars_parseEnvVars(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties string
+		want       map[string]string
+	}{
+		{
+			name:       "comments",
+			properties: "# comment\n! also a comment\n\n   \nFOO=bar",
+			want:       map[string]string{"FOO": "bar"},
+		},
+		{
+			name:       "separators",
+			properties: "A=1\nB:2\nC 3\nD = 4\nE\t:\t5\nF\nG==7",
+			want:       map[string]string{"A": "1", "B": "2", "C": "3", "D": "4", "E": "5", "F": "", "G": "=7"},
+		},
+		{
+			name:       "continuation",
+			properties: "LIST=one, \\\n    two, \\\r\n    three\nNEXT=value",
+			want:       map[string]string{"LIST": "one, two, three", "NEXT": "value"},
+		},
+		{
+			name:       "escaped-backslash",
+			properties: `PATH=C:\\tools\\` + "\nNEXT=value",
+			want:       map[string]string{"PATH": `C:\tools\`, "NEXT": "value"},
+		},
+		{
+			name:       "escapes",
+			properties: `KEY\ WITH\=SEPARATORS\:=a\tb\nc\u00e9\ud83d\ude00\q`,
+			want:       map[string]string{"KEY WITH=SEPARATORS:": "a\tb\ncé😀q"},
+		},
+		{
+			name:       "leading-whitespace",
+			properties: "  FOO=  bar  ",
+			want:       map[string]string{"FOO": "bar  "},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &folderEnvVars{Properties: tt.properties}
+			if got := e.parseEnvVars(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("folderEnvVars.parseEnvVars() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parseFolderPermission(t *testing.T) {
+	tests := []struct {
+		entry string
+		want  folderPermission
+		short string
+	}{
+		{
+			entry: "hudson.model.Item.Read:alice",
+			want:  folderPermission{Permission: "hudson.model.Item.Read", Principal: "alice"},
+			short: "Job/Read",
+		},
+		{
+			entry: "USER:hudson.model.Item.Build:alice",
+			want:  folderPermission{Type: "USER", Permission: "hudson.model.Item.Build", Principal: "alice"},
+			short: "Job/Build",
+		},
+		{
+			entry: "GROUP:com.cloudbees.plugins.credentials.CredentialsProvider.View:ops:admins",
+			want:  folderPermission{Type: "GROUP", Permission: "com.cloudbees.plugins.credentials.CredentialsProvider.View", Principal: "ops:admins"},
+			short: "Credentials/View",
+		},
+		{
+			entry: "org.example.Custom.Permission:bob",
+			want:  folderPermission{Permission: "org.example.Custom.Permission", Principal: "bob"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.entry, func(t *testing.T) {
+			got := parseFolderPermission(tt.entry)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseFolderPermission() = %#v, want %#v", got, tt.want)
+			}
+			if got.String() != tt.entry {
+				t.Errorf("String() = %q, want %q", got.String(), tt.entry)
+			}
+			if got.shortName() != tt.short {
+				t.Errorf("shortName() = %q, want %q", got.shortName(), tt.short)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package jenkins
+
+import (
+	"encoding/xml"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// pipelineLibrarySCMRetrieverClass is the retriever used for libraries that are
+	// loaded from a "Legacy SCM" or "Modern SCM" source.
+	pipelineLibrarySCMRetrieverClass = "org.jenkinsci.plugins.workflow.libs.SCMSourceRetriever"
+
+	// pipelineLibraryGitSCMClass is the SCM source used for newly created libraries.
+	pipelineLibraryGitSCMClass = "jenkins.plugins.git.GitSCMSource"
+)
+
+// pipelineLibraries represents the list of libraries stored by both the
+// FolderLibraries property and the GlobalLibraries configuration.
+type pipelineLibraries struct {
+	Plugin    string            `xml:"plugin,attr,omitempty"`
+	Libraries []pipelineLibrary `xml:"libraries>org.jenkinsci.plugins.workflow.libs.LibraryConfiguration"`
+}
+
+// pipelineLibraryList represents the libraries of the GlobalLibraries configuration, as
+// serialized by XStream when exchanged through the script console.
+type pipelineLibraryList struct {
+	XMLName   xml.Name          `xml:"list"`
+	Libraries []pipelineLibrary `xml:"org.jenkinsci.plugins.workflow.libs.LibraryConfiguration"`
+}
+
+type pipelineLibrary struct {
+	Name                 string                    `xml:"name"`
+	Retriever            *pipelineLibraryRetriever `xml:"retriever,omitempty"`
+	DefaultVersion       string                    `xml:"defaultVersion,omitempty"`
+	Implicit             bool                      `xml:"implicit"`
+	AllowVersionOverride bool                      `xml:"allowVersionOverride"`
+	IncludeInChangesets  bool                      `xml:"includeInChangesets"`
+	CachingConfiguration *pipelineLibraryCaching   `xml:"cachingConfiguration,omitempty"`
+	Other                []xmlRawProperty          `xml:",any"`
+}
+
+type pipelineLibraryRetriever struct {
+	Class string             `xml:"class,attr"`
+	SCM   pipelineLibrarySCM `xml:"scm"`
+	Other []xmlRawProperty   `xml:",any"`
+}
+
+// pipelineLibrarySCM is the SCM source of a library. Only Git sources are managed, so the
+// configuration of any other SCM is retained as-is.
+type pipelineLibrarySCM struct {
+	Class         string           `xml:"class,attr"`
+	Plugin        string           `xml:"plugin,attr,omitempty"`
+	ID            string           `xml:"id,omitempty"`
+	Remote        string           `xml:"remote"`
+	CredentialsID string           `xml:"credentialsId,omitempty"`
+	Other         []xmlRawProperty `xml:",any"`
+
+	raw *xmlRawProperty
+}
+
+func (s *pipelineLibrarySCM) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "class" {
+			s.Class = attr.Value
+		}
+	}
+
+	if s.Class != pipelineLibraryGitSCMClass {
+		s.raw = &xmlRawProperty{}
+		return d.DecodeElement(s.raw, &start)
+	}
+
+	type plain pipelineLibrarySCM
+	return d.DecodeElement((*plain)(s), &start)
+}
+
+func (s pipelineLibrarySCM) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if s.raw != nil {
+		return e.EncodeElement(s.raw, start)
+	}
+
+	type plain pipelineLibrarySCM
+	return e.EncodeElement(plain(s), start)
+}
+
+type pipelineLibraryCaching struct {
+	RefreshTimeMinutes  int              `xml:"refreshTimeMinutes"`
+	ExcludedVersionsStr string           `xml:"excludedVersionsStr"`
+	Other               []xmlRawProperty `xml:",any"`
+}
+
+// pipelineLibraryCachingModel is the Terraform representation of a library's caching settings.
+type pipelineLibraryCachingModel struct {
+	RefreshTimeMinutes types.Int64  `tfsdk:"refresh_time_minutes"`
+	ExcludedVersions   types.String `tfsdk:"excluded_versions"`
+}
+
+func (m *pipelineLibraryCachingModel) expand() *pipelineLibraryCaching {
+	if m == nil {
+		return nil
+	}
+
+	return &pipelineLibraryCaching{
+		RefreshTimeMinutes:  int(m.RefreshTimeMinutes.ValueInt64()),
+		ExcludedVersionsStr: m.ExcludedVersions.ValueString(),
+	}
+}
+
+func flattenPipelineLibraryCaching(c *pipelineLibraryCaching) *pipelineLibraryCachingModel {
+	if c == nil {
+		return nil
+	}
+
+	return &pipelineLibraryCachingModel{
+		RefreshTimeMinutes: types.Int64Value(int64(c.RefreshTimeMinutes)),
+		ExcludedVersions:   types.StringValue(c.ExcludedVersionsStr),
+	}
+}
+
+// newPipelineLibraryRetriever builds the default Git retriever for a library that
+// did not previously exist in Jenkins.
+func newPipelineLibraryRetriever() *pipelineLibraryRetriever {
+	return &pipelineLibraryRetriever{
+		Class: pipelineLibrarySCMRetrieverClass,
+		SCM: pipelineLibrarySCM{
+			Class: pipelineLibraryGitSCMClass,
+			Other: []xmlRawProperty{
+				{
+					XMLName: xml.Name{Local: "traits"},
+					Raw:     "<jenkins.plugins.git.traits.BranchDiscoveryTrait/>",
+				},
+			},
+		},
+	}
+}
+
+// find returns the index of the library with the given name, or -1 if it does not exist.
+func (l *pipelineLibraries) find(name string) int {
+	return findPipelineLibrary(l.Libraries, name)
+}
+
+// find returns the index of the library with the given name, or -1 if it does not exist.
+func (l *pipelineLibraryList) find(name string) int {
+	return findPipelineLibrary(l.Libraries, name)
+}
+
+func findPipelineLibrary(libs []pipelineLibrary, name string) int {
+	for i, lib := range libs {
+		if lib.Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// isGit returns whether the library is retrieved from a Git SCM source, which is the only
+// kind of retriever that is managed.
+func (l *pipelineLibrary) isGit() bool {
+	return l.Retriever != nil && l.Retriever.Class == pipelineLibrarySCMRetrieverClass &&
+		l.Retriever.SCM.Class == pipelineLibraryGitSCMClass
+}
+
+// setSCM points the library at the given Git remote. Git retrievers already present on
+// the library are updated in place so that any unmanaged settings are preserved. Other
+// retrievers are left untouched unless a remote is given, in which case they are replaced.
+func (l *pipelineLibrary) setSCM(remote, credentialsID string) {
+	if !l.isGit() {
+		if remote == "" {
+			return
+		}
+		l.Retriever = newPipelineLibraryRetriever()
+	}
+
+	l.Retriever.SCM.Remote = remote
+	l.Retriever.SCM.CredentialsID = credentialsID
+}
+
+// setCaching enables library caching with the given settings, or disables it if nil.
+func (l *pipelineLibrary) setCaching(caching *pipelineLibraryCaching) {
+	if caching == nil {
+		l.CachingConfiguration = nil
+		return
+	}
+
+	if l.CachingConfiguration == nil {
+		l.CachingConfiguration = &pipelineLibraryCaching{}
+	}
+	l.CachingConfiguration.RefreshTimeMinutes = caching.RefreshTimeMinutes
+	l.CachingConfiguration.ExcludedVersionsStr = caching.ExcludedVersionsStr
+}
+
+// remote returns the Git remote and credentials of the library, if it has any.
+func (l *pipelineLibrary) remote() (remote string, credentialsID string) {
+	if !l.isGit() {
+		return "", ""
+	}
+
+	return l.Retriever.SCM.Remote, l.Retriever.SCM.CredentialsID
+}
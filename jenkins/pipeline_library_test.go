@@ -0,0 +1,250 @@
+package jenkins
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_pipelineLibraries_find(t *testing.T) {
+	libs := &pipelineLibraries{
+		Libraries: []pipelineLibrary{
+			{Name: "first"},
+			{Name: "second"},
+		},
+	}
+
+	if got := libs.find("second"); got != 1 {
+		t.Errorf("pipelineLibraries.find() = %d, want %d", got, 1)
+	}
+	if got := libs.find("missing"); got != -1 {
+		t.Errorf("pipelineLibraries.find() = %d, want %d", got, -1)
+	}
+}
+
+func Test_pipelineLibrary_setSCM(t *testing.T) {
+	tests := []struct {
+		name          string
+		lib           pipelineLibrary
+		remote        string
+		credentialsID string
+		want          *pipelineLibraryRetriever
+	}{
+		{
+			name:   "no-remote",
+			lib:    pipelineLibrary{},
+			remote: "",
+			want:   nil,
+		},
+		{
+			name:          "new-retriever",
+			lib:           pipelineLibrary{},
+			remote:        "https://github.com/example/library.git",
+			credentialsID: "github",
+			want: &pipelineLibraryRetriever{
+				Class: pipelineLibrarySCMRetrieverClass,
+				SCM: pipelineLibrarySCM{
+					Class:         pipelineLibraryGitSCMClass,
+					Remote:        "https://github.com/example/library.git",
+					CredentialsID: "github",
+					Other: []xmlRawProperty{
+						{
+							XMLName: xml.Name{Local: "traits"},
+							Raw:     "<jenkins.plugins.git.traits.BranchDiscoveryTrait/>",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "existing-retriever",
+			lib: pipelineLibrary{
+				Retriever: &pipelineLibraryRetriever{
+					Class: pipelineLibrarySCMRetrieverClass,
+					SCM: pipelineLibrarySCM{
+						Class:  pipelineLibraryGitSCMClass,
+						ID:     "abc",
+						Remote: "https://github.com/example/old.git",
+					},
+				},
+			},
+			remote: "https://github.com/example/new.git",
+			want: &pipelineLibraryRetriever{
+				Class: pipelineLibrarySCMRetrieverClass,
+				SCM: pipelineLibrarySCM{
+					Class:  pipelineLibraryGitSCMClass,
+					ID:     "abc",
+					Remote: "https://github.com/example/new.git",
+				},
+			},
+		},
+		{
+			name: "unmanaged-retriever",
+			lib: pipelineLibrary{
+				Retriever: &pipelineLibraryRetriever{
+					Class: pipelineLibrarySCMRetrieverClass,
+					SCM:   pipelineLibrarySCM{Class: "org.jenkinsci.plugins.github_branch_source.GitHubSCMSource"},
+				},
+			},
+			remote: "",
+			want: &pipelineLibraryRetriever{
+				Class: pipelineLibrarySCMRetrieverClass,
+				SCM:   pipelineLibrarySCM{Class: "org.jenkinsci.plugins.github_branch_source.GitHubSCMSource"},
+			},
+		},
+		{
+			name: "replaced-retriever",
+			lib: pipelineLibrary{
+				Retriever: &pipelineLibraryRetriever{
+					Class: "org.jenkinsci.plugins.workflow.libs.SCMRetriever",
+					SCM:   pipelineLibrarySCM{Class: "hudson.plugins.git.GitSCM"},
+				},
+			},
+			remote: "https://github.com/example/library.git",
+			want: &pipelineLibraryRetriever{
+				Class: pipelineLibrarySCMRetrieverClass,
+				SCM: pipelineLibrarySCM{
+					Class:  pipelineLibraryGitSCMClass,
+					Remote: "https://github.com/example/library.git",
+					Other: []xmlRawProperty{
+						{
+							XMLName: xml.Name{Local: "traits"},
+							Raw:     "<jenkins.plugins.git.traits.BranchDiscoveryTrait/>",
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.lib.setSCM(tt.remote, tt.credentialsID)
+			if !reflect.DeepEqual(tt.lib.Retriever, tt.want) {
+				t.Errorf("pipelineLibrary.setSCM() = %#v, want %#v", tt.lib.Retriever, tt.want)
+			}
+		})
+	}
+}
+
+func Test_pipelineLibraryList_Unmarshal(t *testing.T) {
+	def := `<list>
+  <org.jenkinsci.plugins.workflow.libs.LibraryConfiguration>
+    <name>shared</name>
+    <retriever class="org.jenkinsci.plugins.workflow.libs.SCMSourceRetriever">
+      <clone>false</clone>
+      <scm class="jenkins.plugins.git.GitSCMSource" plugin="git@5.2.0">
+        <id>8c6a1e5e</id>
+        <remote>https://github.com/example/shared-library.git</remote>
+        <credentialsId>github</credentialsId>
+      </scm>
+    </retriever>
+    <defaultVersion>main</defaultVersion>
+    <implicit>true</implicit>
+    <allowVersionOverride>false</allowVersionOverride>
+    <includeInChangesets>true</includeInChangesets>
+    <cachingConfiguration>
+      <refreshTimeMinutes>60</refreshTimeMinutes>
+      <excludedVersionsStr>main</excludedVersionsStr>
+    </cachingConfiguration>
+  </org.jenkinsci.plugins.workflow.libs.LibraryConfiguration>
+</list>`
+
+	got := pipelineLibraryList{}
+	if err := xml.Unmarshal([]byte(def), &got); err != nil {
+		t.Fatalf("xml.Unmarshal() error = %v", err)
+	}
+
+	if len(got.Libraries) != 1 {
+		t.Fatalf("xml.Unmarshal() returned %d libraries, want 1", len(got.Libraries))
+	}
+
+	lib := got.Libraries[0]
+	if remote, credentialsID := lib.remote(); remote != "https://github.com/example/shared-library.git" || credentialsID != "github" {
+		t.Errorf("pipelineLibrary.remote() = %q, %q", remote, credentialsID)
+	}
+	if lib.DefaultVersion != "main" || !lib.Implicit || lib.AllowVersionOverride || !lib.IncludeInChangesets {
+		t.Errorf("xml.Unmarshal() = %#v", lib)
+	}
+	if lib.CachingConfiguration == nil || lib.CachingConfiguration.RefreshTimeMinutes != 60 {
+		t.Errorf("xml.Unmarshal() caching = %#v", lib.CachingConfiguration)
+	}
+
+	// The unmanaged "clone" setting of the retriever must survive a round trip
+	rendered, err := xml.Marshal(got)
+	if err != nil {
+		t.Fatalf("xml.Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(rendered), "<clone>false</clone>") {
+		t.Errorf("xml.Marshal() = %s, want retriever settings to be retained", rendered)
+	}
+}
+
+func Test_pipelineLibrary_unmanagedSCM(t *testing.T) {
+	tests := []struct {
+		name      string
+		retriever string
+	}{
+		{
+			name: "github",
+			retriever: `<retriever class="org.jenkinsci.plugins.workflow.libs.SCMSourceRetriever">
+      <scm class="org.jenkinsci.plugins.github_branch_source.GitHubSCMSource" plugin="github-branch-source@1785.v99802b_69816c">
+        <id>8c6a1e5e</id>
+        <credentialsId>github</credentialsId>
+        <repoOwner>example</repoOwner>
+        <repository>shared-library</repository>
+      </scm>
+    </retriever>`,
+		},
+		{
+			name: "legacy",
+			retriever: `<retriever class="org.jenkinsci.plugins.workflow.libs.SCMRetriever">
+      <scm class="hudson.plugins.git.GitSCM" plugin="git@5.2.0">
+        <configVersion>2</configVersion>
+        <userRemoteConfigs>
+          <hudson.plugins.git.UserRemoteConfig>
+            <url>https://github.com/example/shared-library.git</url>
+          </hudson.plugins.git.UserRemoteConfig>
+        </userRemoteConfigs>
+      </scm>
+    </retriever>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def := `<list>
+  <org.jenkinsci.plugins.workflow.libs.LibraryConfiguration>
+    <name>shared</name>
+    ` + tt.retriever + `
+  </org.jenkinsci.plugins.workflow.libs.LibraryConfiguration>
+</list>`
+
+			got := pipelineLibraryList{}
+			if err := xml.Unmarshal([]byte(def), &got); err != nil {
+				t.Fatalf("xml.Unmarshal() error = %v", err)
+			}
+
+			lib := &got.Libraries[0]
+			if remote, credentialsID := lib.remote(); remote != "" || credentialsID != "" {
+				t.Errorf("pipelineLibrary.remote() = %q, %q, want the SCM to be unmanaged", remote, credentialsID)
+			}
+
+			lib.setSCM("", "")
+			rendered, err := xml.Marshal(got)
+			if err != nil {
+				t.Fatalf("xml.Marshal() error = %v", err)
+			}
+
+			// Compare the SCM contents ignoring whitespace, as attributes may be reordered
+			scm := tt.retriever[strings.Index(tt.retriever, "<scm"):strings.Index(tt.retriever, "</scm>")]
+			want := scm[strings.Index(scm, ">")+1:]
+			if compact := strings.Join(strings.Fields(want), ""); !strings.Contains(strings.Join(strings.Fields(string(rendered)), ""), compact) {
+				t.Errorf("xml.Marshal() = %s, want it to contain %s", rendered, want)
+			}
+			if strings.Contains(string(rendered), "<remote>") {
+				t.Errorf("xml.Marshal() = %s, want no remote to be added", rendered)
+			}
+		})
+	}
+}
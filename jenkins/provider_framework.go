@@ -122,9 +122,16 @@ func (p *JenkinsProvider) Configure(ctx context.Context, req provider.ConfigureR
 // DataSources satisfies the provider.Provider interface for JenkinsProvider.
 func (p *JenkinsProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		newCredentialGitHubAppDataSource,
 		newCredentialUsernameDataSource,
 		newCredentialVaultAppRoleDataSource,
 		newCredentialAwsDataSource,
+		newCredentialAzureServicePrincipalDataSource,
+		newCredentialSecretFileDataSource,
+		newCredentialSecretTextDataSource,
+		newCredentialSSHDataSource,
+		newCredentialUsageDataSource,
+		newCredentialsDataSource,
 		newViewDataSource,
 		newJobDataSource,
 		newFolderDataSource,
@@ -135,12 +142,23 @@ func (p *JenkinsProvider) DataSources(ctx context.Context) []func() datasource.D
 func (p *JenkinsProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		newCredentialAzureServicePrincipalResource,
+		newCredentialCertificateResource,
+		newCredentialDockerServerResource,
+		newCredentialDomainResource,
+		newCredentialGCPServiceAccountResource,
+		newCredentialGitHubAppResource,
 		newCredentialSecretFileResource,
 		newCredentialSecretTextResource,
 		newCredentialSSHResource,
 		newCredentialUsernameResource,
 		newCredentialVaultAppRoleResource,
+		newCredentialVaultGitHubTokenResource,
+		newCredentialVaultKubernetesResource,
+		newCredentialVaultTokenResource,
 		newcredentialAwsResource,
+		newCredentialsProviderConfigResource,
+		newFolderPipelineLibraryResource,
+		newGlobalPipelineLibraryResource,
 		newViewResource,
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -67,3 +68,24 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("JENKINS_PASSWORD must be set for acceptance tests")
 	}
 }
+
+// testAccBuildJob runs a build of the given job and waits for it to succeed.
+func testAccBuildJob(t *testing.T, name string) {
+	ctx := context.Background()
+
+	queueID, err := testAccClient.BuildJob(ctx, name, nil)
+	if err != nil {
+		t.Fatalf("Unable to build job %s: %s", name, err)
+	}
+	build, err := testAccClient.GetBuildFromQueueID(ctx, queueID)
+	if err != nil {
+		t.Fatalf("Unable to retrieve build of job %s: %s", name, err)
+	}
+
+	for build.IsRunning(ctx) {
+		time.Sleep(time.Second)
+	}
+	if result := build.GetResult(); result != "SUCCESS" {
+		t.Fatalf("Build of job %s finished with result %s", name, result)
+	}
+}
@@ -6,14 +6,20 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type (
@@ -65,8 +71,12 @@ func (r *resourceHelper) ImportState(ctx context.Context, req resource.ImportSta
 	domain := splitID[len(splitID)-2]
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), domain)...)
 
-	folder := strings.Trim(strings.Join(splitID[0:len(splitID)-2], "/"), "/")
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("folder"), folder)...)
+	// Use the canonical folder path, as exported by jenkins_folder, leaving it unset for global credentials
+	// so that generated configuration matches what would have been written by hand
+	folder := formatFolderID(extractFolders(strings.Join(splitID[0:len(splitID)-2], "/")))
+	if folder != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("folder"), folder)...)
+	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), generateCredentialID(folder, name))...)
 }
@@ -109,6 +119,26 @@ func (r *resourceHelper) schema(s map[string]schema.Attribute) map[string]schema
 	return s
 }
 func (r *resourceHelper) schemaCredential(s map[string]schema.Attribute) map[string]schema.Attribute {
+	// Credentials may be moved between folders in place, changing their ID
+	if _, ok := s["id"]; !ok {
+		s["id"] = schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The unique identifier of the credentials, made up of their canonical folder path and name, e.g. `/job/folder-name/credential-name`. Credentials stored outside of a folder are identified by `/credential-name`.",
+			PlanModifiers: []planmodifier.String{
+				useStateForUnknownUnlessFolderChanges{},
+			},
+		}
+	}
+	if _, ok := s["folder"]; !ok {
+		s["folder"] = schema.StringAttribute{
+			MarkdownDescription: "The folder namespace to store the resource in. If not set will default to global Jenkins. Changing the folder moves the existing credentials, except when moving them between a folder and global Jenkins, which recreates them.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIf(requiresReplaceIfCredentialStoreChanges, "", ""),
+			},
+		}
+	}
+
 	// Pull in the base schema
 	s = r.schema(s)
 
@@ -123,14 +153,10 @@ func (r *resourceHelper) schemaCredential(s map[string]schema.Attribute) map[str
 	}
 	if _, ok := s["domain"]; !ok {
 		s["domain"] = schema.StringAttribute{
-			MarkdownDescription: "The domain store to place the credentials into. If not set will default to the global credentials store.",
+			MarkdownDescription: "The domain store to place the credentials into. If not set will default to the global credentials store. Changing the domain moves the existing credentials.",
 			Optional:            true,
 			Computed:            true,
 			Default:             stringdefault.StaticString(defaultCredentialDomain),
-			PlanModifiers: []planmodifier.String{
-				// In-place updates should be possible, but gojenkins does not support move operations
-				stringplanmodifier.RequiresReplace(),
-			},
 		}
 	}
 	if _, ok := s["scope"]; !ok {
@@ -147,3 +173,315 @@ func (r *resourceHelper) schemaCredential(s map[string]schema.Attribute) map[str
 
 	return s
 }
+
+// schemaSecrets adds a write-only "<name>_wo" companion for each of the given secret attributes,
+// along with a "<name>_wo_version" attribute used to trigger updates of the write-only value.
+// Secrets that were required may then be given through either attribute. The attributes used
+// to detect drift of the secrets are added as well.
+func (r *resourceHelper) schemaSecrets(s map[string]schema.Attribute, names ...string) map[string]schema.Attribute {
+	s["detect_secret_drift"] = schema.BoolAttribute{
+		MarkdownDescription: "Whether to detect changes made to the credentials outside of Terraform, such as a secret being replaced within the Jenkins UI. If so the secrets are planned to be updated again. Changes are detected through the fingerprint Jenkins records for credentials, which only exists once they have been used by a build, so changes are only detected once both the applied and the changed credentials have been used. Defaults to `false`.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
+	s["secret_fingerprint"] = schema.StringAttribute{
+		MarkdownDescription: "The fingerprint Jenkins recorded for the credentials as last applied, used when `detect_secret_drift` is enabled. Unset until the credentials have been used by a build.",
+		Computed:            true,
+	}
+
+	for _, name := range names {
+		attr := s[name].(schema.StringAttribute)
+		writeOnly := path.MatchRoot(name + "_wo")
+
+		// The write-only value must satisfy the same validation as the secret it replaces
+		validators := append([]validator.String{stringvalidator.ConflictsWith(path.MatchRoot(name))}, attr.Validators...)
+
+		if attr.Required {
+			attr.Required = false
+			attr.Optional = true
+			attr.Validators = append(attr.Validators, stringvalidator.ExactlyOneOf(path.MatchRoot(name), writeOnly))
+		} else {
+			attr.Validators = append(attr.Validators, stringvalidator.ConflictsWith(writeOnly))
+		}
+		s[name] = attr
+
+		s[name+"_wo"] = schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Write-only variant of `%s`, which is never stored within the Terraform state. Requires Terraform 1.11 or later.", name),
+			Optional:            true,
+			Sensitive:           true,
+			WriteOnly:           true,
+			Validators:          validators,
+		}
+		s[name+"_wo_version"] = schema.Int64Attribute{
+			MarkdownDescription: fmt.Sprintf("The version of `%s_wo`. As write-only values are not stored, changing this version is what triggers an update of the secret within Jenkins.", name),
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(writeOnly),
+			},
+		}
+	}
+
+	return s
+}
+
+// secretsWarning returns the warning included in the description of resources holding the given secret
+// attributes, pointing to the write-only companions added by schemaSecrets.
+func secretsWarning(names ...string) string {
+	list := func(suffix string) string {
+		quoted := make([]string, len(names))
+		for i, name := range names {
+			quoted[i] = `"` + name + suffix + `"`
+		}
+		if len(quoted) == 1 {
+			return quoted[0]
+		}
+		return strings.Join(quoted[:len(quoted)-1], ", ") + " and " + quoted[len(quoted)-1]
+	}
+
+	if len(names) == 1 {
+		return fmt.Sprintf(`~> The %s property may leave a plain-text secret in your state file. Ensure that your state file is properly secured and encrypted at rest. With Terraform 1.11 or later, use the write-only %s property instead to keep it out of your state file.`, list(""), list("_wo"))
+	}
+	return fmt.Sprintf(`~> The %s properties may leave plain-text secrets in your state file. Ensure that your state file is properly secured and encrypted at rest. With Terraform 1.11 or later, use the write-only %s properties instead to keep them out of your state file.`, list(""), list("_wo"))
+}
+
+// writeOnlySecret returns the value of the given secret attribute, or if it is not set the value of its
+// write-only companion. Write-only values are only ever present within the configuration.
+func writeOnlySecret(ctx context.Context, config tfsdk.Config, value types.String, name string) (types.String, diag.Diagnostics) {
+	if !value.IsNull() {
+		return value, nil
+	}
+
+	var ret types.String
+	diags := config.GetAttribute(ctx, path.Root(name+"_wo"), &ret)
+	return ret, diags
+}
+
+// recordSecretFingerprint stores the fingerprint of the credentials that were just applied, if
+// drift detection is enabled for the resource.
+func (r *resourceHelper) recordSecretFingerprint(ctx context.Context, state *tfsdk.State) diag.Diagnostics {
+	var detect types.Bool
+	var name, folder, domain types.String
+	diags := state.GetAttribute(ctx, path.Root("detect_secret_drift"), &detect)
+	diags.Append(state.GetAttribute(ctx, path.Root("name"), &name)...)
+	diags.Append(state.GetAttribute(ctx, path.Root("folder"), &folder)...)
+	diags.Append(state.GetAttribute(ctx, path.Root("domain"), &domain)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !detect.ValueBool() {
+		return state.SetAttribute(ctx, path.Root("secret_fingerprint"), types.StringNull())
+	}
+
+	fingerprint, err := r.client.GetCredentialFingerprint(ctx, folder.ValueString(), domain.ValueString(), name.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Unable to Fingerprint Resource",
+			"An unexpected error occurred while retrieving the fingerprint of the credentials. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	return state.SetAttribute(ctx, path.Root("secret_fingerprint"), optionalStringValue(fingerprint))
+}
+
+// detectSecretDrift compares the fingerprint of the credentials against the one recorded when they
+// were last applied, if drift detection is enabled for the resource. Upon drift the given secret
+// attributes are cleared from the state, so that the next apply enforces them again. Credentials
+// that were unused when applied have no fingerprint, so the first one Jenkins records is adopted.
+func (r *resourceHelper) detectSecretDrift(ctx context.Context, state *tfsdk.State, names ...string) diag.Diagnostics {
+	var detect types.Bool
+	var name, folder, domain, recorded types.String
+	diags := state.GetAttribute(ctx, path.Root("detect_secret_drift"), &detect)
+	diags.Append(state.GetAttribute(ctx, path.Root("name"), &name)...)
+	diags.Append(state.GetAttribute(ctx, path.Root("folder"), &folder)...)
+	diags.Append(state.GetAttribute(ctx, path.Root("domain"), &domain)...)
+	diags.Append(state.GetAttribute(ctx, path.Root("secret_fingerprint"), &recorded)...)
+	if detect.IsNull() {
+		// Imported credentials have no recorded preference, so apply the default
+		diags.Append(state.SetAttribute(ctx, path.Root("detect_secret_drift"), false)...)
+	}
+	if diags.HasError() || !detect.ValueBool() {
+		return diags
+	}
+
+	fingerprint, err := r.client.GetCredentialFingerprint(ctx, folder.ValueString(), domain.ValueString(), name.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while retrieving the fingerprint of the credentials. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	if recorded.IsNull() {
+		return state.SetAttribute(ctx, path.Root("secret_fingerprint"), optionalStringValue(fingerprint))
+	}
+
+	// Changed credentials are only fingerprinted once they are used, so an empty fingerprint is not drift
+	if fingerprint == "" || fingerprint == recorded.ValueString() {
+		return diags
+	}
+
+	for _, secret := range names {
+		diags.Append(state.SetAttribute(ctx, path.Root(secret), types.StringNull())...)
+		diags.Append(state.SetAttribute(ctx, path.Root(secret+"_wo_version"), types.Int64Null())...)
+	}
+
+	return diags
+}
+
+// moveCredential relocates the credentials being updated when their planned folder or domain
+// differs from the prior state. Jenkins retains the credentials' secrets during the move.
+func (r *resourceHelper) moveCredential(ctx context.Context, req resource.UpdateRequest) error {
+	var name, folder, domain, plannedFolder, plannedDomain types.String
+	diags := req.State.GetAttribute(ctx, path.Root("name"), &name)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("folder"), &folder)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("domain"), &domain)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("folder"), &plannedFolder)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("domain"), &plannedDomain)...)
+	if diags.HasError() {
+		return fmt.Errorf("could not read credential location: %v", diags)
+	}
+
+	if formatFolderName(folder.ValueString()) == formatFolderName(plannedFolder.ValueString()) &&
+		domain.ValueString() == plannedDomain.ValueString() {
+		return nil
+	}
+
+	// Validate that the destination folder exists
+	if err := folderExists(ctx, r.client, formatFolderName(plannedFolder.ValueString())); err != nil {
+		return fmt.Errorf("could not find folder %q: %w", plannedFolder.ValueString(), err)
+	}
+
+	return r.client.MoveCredential(ctx,
+		folder.ValueString(), domain.ValueString(), name.ValueString(),
+		plannedFolder.ValueString(), plannedDomain.ValueString(),
+	)
+}
+
+// requiresReplaceIfCredentialStoreChanges recreates credentials that move between the global credentials store and
+// a folder store. Jenkins is only able to move credentials between stores of the same kind, as it locates the
+// destination by the name of the source store.
+func requiresReplaceIfCredentialStoreChanges(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = len(extractFolders(req.StateValue.ValueString())) == 0 != (len(extractFolders(req.PlanValue.ValueString())) == 0)
+}
+
+// useStateForUnknownUnlessFolderChanges copies the prior state value into the plan, unless the
+// "folder" attribute of the resource changes. It is used for IDs that are derived from the folder.
+type useStateForUnknownUnlessFolderChanges struct{}
+
+func (m useStateForUnknownUnlessFolderChanges) Description(_ context.Context) string {
+	return "Once set, the value of this attribute in state will not change unless the folder changes."
+}
+
+func (m useStateForUnknownUnlessFolderChanges) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m useStateForUnknownUnlessFolderChanges) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing on resource creation, or if the value is already known
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var folder, plannedFolder types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("folder"), &folder)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("folder"), &plannedFolder)...)
+	if resp.Diagnostics.HasError() || plannedFolder.IsUnknown() {
+		return
+	}
+
+	if formatFolderName(folder.ValueString()) == formatFolderName(plannedFolder.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// schemaPipelineLibrary provides the attributes shared by all Pipeline shared library resources.
+// Each resource is expected to supply its own "id" and, where applicable, "folder" attributes.
+func (r *resourceHelper) schemaPipelineLibrary(s map[string]schema.Attribute) map[string]schema.Attribute {
+	if _, ok := s["name"]; !ok {
+		s["name"] = schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "The name used to reference the library from a Jenkinsfile, e.g. `@Library('name')`.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+	if _, ok := s["default_version"]; !ok {
+		s["default_version"] = schema.StringAttribute{
+			MarkdownDescription: "The branch, tag or commit to load when no version is requested.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+		}
+	}
+	if _, ok := s["implicit"]; !ok {
+		s["implicit"] = schema.BoolAttribute{
+			MarkdownDescription: "Whether the library is loaded automatically, without an explicit `@Library` annotation. Defaults to `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		}
+	}
+	if _, ok := s["allow_version_override"]; !ok {
+		s["allow_version_override"] = schema.BoolAttribute{
+			MarkdownDescription: "Whether jobs may request a version other than the default. Defaults to `true`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		}
+	}
+	if _, ok := s["include_in_changesets"]; !ok {
+		s["include_in_changesets"] = schema.BoolAttribute{
+			MarkdownDescription: "Whether changes to the library are included in the changesets of builds. Defaults to `true`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		}
+	}
+	if _, ok := s["remote"]; !ok {
+		s["remote"] = schema.StringAttribute{
+			MarkdownDescription: "The Git repository URL to retrieve the library from.",
+			Required:            true,
+		}
+	}
+	if _, ok := s["credentials_id"]; !ok {
+		s["credentials_id"] = schema.StringAttribute{
+			MarkdownDescription: "The ID of the credentials used to check out the Git repository.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+		}
+	}
+	if _, ok := s["caching"]; !ok {
+		s["caching"] = schema.SingleNestedAttribute{
+			MarkdownDescription: "Enables caching of the library on the controller. If not set, the library is retrieved on every build.",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"refresh_time_minutes": schema.Int64Attribute{
+					MarkdownDescription: "The age in minutes after which the cache is refreshed. If `0` the cache is never refreshed.",
+					Optional:            true,
+					Computed:            true,
+					Default:             int64default.StaticInt64(0),
+				},
+				"excluded_versions": schema.StringAttribute{
+					MarkdownDescription: "A space separated list of versions that should never be cached.",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString(""),
+				},
+			},
+		}
+	}
+
+	return s
+}
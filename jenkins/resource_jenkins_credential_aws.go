@@ -32,8 +32,12 @@ type credentialAwsResourceModel struct {
 	Scope              types.String `tfsdk:"scope"`
 	AccessKey          types.String `tfsdk:"access_key"`
 	SecretKey          types.String `tfsdk:"secret_key"`
+	SecretKeyWO        types.String `tfsdk:"secret_key_wo"`
+	SecretKeyWOVersion types.Int64  `tfsdk:"secret_key_wo_version"`
 	IamRoleArn         types.String `tfsdk:"iam_role_arn"`
 	IamMfaSerialNumber types.String `tfsdk:"iam_mfa_serial_number"`
+	DetectSecretDrift  types.Bool   `tfsdk:"detect_secret_drift"`
+	SecretFingerprint  types.String `tfsdk:"secret_fingerprint"`
 }
 
 type credentialAwsResource struct {
@@ -60,10 +64,10 @@ func (r *credentialAwsResource) Schema(_ context.Context, _ resource.SchemaReque
 		MarkdownDescription: `
 Manages an AWS credential within Jenkins.
 
-~> The "secret_key" property may leave plain-text secret id in your state file. If using the property to manage the secret id in Terraform, ensure that your state file is properly secured and encrypted at rest.
+` + secretsWarning("secret_key") + `
 
 ~> The Jenkins installation that uses this resource is expected to have the [AWS Credentials Plugin](https://plugins.jenkins.io/aws-credentials/) installed in their system.`,
-		Attributes: r.schemaCredential(map[string]schema.Attribute{
+		Attributes: r.schemaCredential(r.schemaSecrets(map[string]schema.Attribute{
 			"access_key": schema.StringAttribute{
 				MarkdownDescription: "An AWS access key ID. This is the public part of the key pair used to authenticate with AWS services.",
 				Optional:            true,
@@ -90,7 +94,7 @@ Manages an AWS credential within Jenkins.
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
 			},
-		}),
+		}, "secret_key")),
 	}
 }
 
@@ -121,12 +125,18 @@ func (r *credentialAwsResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	secretKey, diags := writeOnlySecret(ctx, req.Config, data.SecretKey, "secret_key")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	cred := credentialAws{
 		ID:                 data.Name.ValueString(),
 		Scope:              data.Scope.ValueString(),
 		Description:        data.Description.ValueString(),
 		AccessKey:          data.AccessKey.ValueString(),
-		SecretKey:          data.SecretKey.ValueString(),
+		SecretKey:          secretKey.ValueString(),
 		IamRoleArn:         data.IamRoleArn.ValueString(),
 		IamMfaSerialNumber: data.IamMfaSerialNumber.ValueString(),
 	}
@@ -149,6 +159,7 @@ func (r *credentialAwsResource) Create(ctx context.Context, req resource.CreateR
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.recordSecretFingerprint(ctx, &resp.State)...)
 }
 
 // Read is called when the provider must read resource values in order
@@ -196,6 +207,7 @@ func (r *credentialAwsResource) Read(ctx context.Context, req resource.ReadReque
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.detectSecretDrift(ctx, &resp.State, "secret_key")...)
 }
 
 // Update is called to update the state of the resource. Config, planned
@@ -210,9 +222,27 @@ func (r *credentialAwsResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	// Relocate the credentials first if their folder or domain has changed
+	if err := r.moveCredential(ctx, req); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to move the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
 	cm := r.client.Credentials()
 	cm.Folder = formatFolderName(data.Folder.ValueString())
 
+	secretKey, diags := writeOnlySecret(ctx, req.Config, data.SecretKey, "secret_key")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	cred := credentialAws{
 		ID:                 data.Name.ValueString(),
 		Scope:              data.Scope.ValueString(),
@@ -223,8 +253,8 @@ func (r *credentialAwsResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	// Only enforce the password if it is non-empty
-	if data.SecretKey.ValueString() != "" {
-		cred.SecretKey = data.SecretKey.ValueString()
+	if secretKey.ValueString() != "" {
+		cred.SecretKey = secretKey.ValueString()
 	}
 
 	err := cm.Update(ctx, data.Domain.ValueString(), data.Name.ValueString(), &cred)
@@ -239,8 +269,11 @@ func (r *credentialAwsResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), data.Name.ValueString()))
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.recordSecretFingerprint(ctx, &resp.State)...)
 }
 
 // Delete is called when the provider must delete the resource. Config
@@ -47,6 +47,8 @@ type credentialAzureServicePrincipalResourceModel struct {
 	SubscriptionId          types.String `tfsdk:"subscription_id"`
 	ClientId                types.String `tfsdk:"client_id"`
 	ClientSecret            types.String `tfsdk:"client_secret"`
+	ClientSecretWO          types.String `tfsdk:"client_secret_wo"`
+	ClientSecretWOVersion   types.Int64  `tfsdk:"client_secret_wo_version"`
 	CertificateId           types.String `tfsdk:"certificate_id"`
 	Tenant                  types.String `tfsdk:"tenant"`
 	AzureEnvironmentName    types.String `tfsdk:"azure_environment_name"`
@@ -54,6 +56,8 @@ type credentialAzureServicePrincipalResourceModel struct {
 	AuthenticationEndpoint  types.String `tfsdk:"authentication_endpoint"`
 	ResourceManagerEndpoint types.String `tfsdk:"resource_manager_endpoint"`
 	GraphEndpoint           types.String `tfsdk:"graph_endpoint"`
+	DetectSecretDrift       types.Bool   `tfsdk:"detect_secret_drift"`
+	SecretFingerprint       types.String `tfsdk:"secret_fingerprint"`
 }
 
 type credentialAzureServicePrincipalResource struct {
@@ -80,10 +84,10 @@ func (r *credentialAzureServicePrincipalResource) Schema(_ context.Context, _ re
 		MarkdownDescription: `
 Manages an Azure Service Principal credential within Jenkins. This credential may then be referenced within jobs that are created.
 
-~> The "client_secret" property may leave plain-text secret id in your state file. If using the property to manage the secret id in Terraform, ensure that your state file is properly secured and encrypted at rest.
+` + secretsWarning("client_secret") + `
 
 ~> The Jenkins installation that uses this resource is expected to have the [Azure Credentials Plugin](https://plugins.jenkins.io/azure-credentials/) installed in their system.`,
-		Attributes: r.schemaCredential(map[string]schema.Attribute{
+		Attributes: r.schemaCredential(r.schemaSecrets(map[string]schema.Attribute{
 			"subscription_id": schema.StringAttribute{
 				MarkdownDescription: "The Azure subscription id mapped to the Azure Service Principal.",
 				Required:            true,
@@ -93,19 +97,19 @@ Manages an Azure Service Principal credential within Jenkins. This credential ma
 				Required:            true,
 			},
 			"client_secret": schema.StringAttribute{
-				MarkdownDescription: "The client secret of the Azure Service Principal. Cannot be used with `certificate_id`. Has to be specified, if `certificate_id` is not specified.",
+				MarkdownDescription: "The client secret of the Azure Service Principal. Cannot be used with `certificate_id`. Either this or `client_secret_wo` has to be specified, if `certificate_id` is not specified.",
 				Sensitive:           true,
 				Optional:            true,
 				Validators: []validator.String{
-					stringvalidator.ExactlyOneOf(path.MatchRoot("client_secret"), path.MatchRoot("certificate_id")),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("client_secret"), path.MatchRoot("client_secret_wo"), path.MatchRoot("certificate_id")),
 				},
 			},
 			"certificate_id": schema.StringAttribute{
-				MarkdownDescription: "The certificate reference of the Azure Service Principal, pointing to a Jenkins certificate credential. Cannot be used with `client_secret`. Has to be specified, if `client_secret` is not specified.",
+				MarkdownDescription: "The certificate reference of the Azure Service Principal, pointing to a Jenkins certificate credential. Cannot be used with `client_secret`. Has to be specified, if neither `client_secret` nor `client_secret_wo` is specified.",
 				Sensitive:           true,
 				Optional:            true,
 				Validators: []validator.String{
-					stringvalidator.ExactlyOneOf(path.MatchRoot("client_secret"), path.MatchRoot("certificate_id")),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("client_secret"), path.MatchRoot("client_secret_wo"), path.MatchRoot("certificate_id")),
 				},
 			},
 			"tenant": schema.StringAttribute{
@@ -145,7 +149,7 @@ Manages an Azure Service Principal credential within Jenkins. This credential ma
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
 			},
-		}),
+		}, "client_secret")),
 	}
 }
 
@@ -176,10 +180,16 @@ func (r *credentialAzureServicePrincipalResource) Create(ctx context.Context, re
 		return
 	}
 
+	clientSecret, diags := writeOnlySecret(ctx, req.Config, data.ClientSecret, "client_secret")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	credData := AzureServicePrincipalCredentialsData{
 		SubscriptionId:          data.SubscriptionId.ValueString(),
 		ClientId:                data.ClientId.ValueString(),
-		ClientSecret:            data.ClientSecret.ValueString(),
+		ClientSecret:            clientSecret.ValueString(),
 		CertificateId:           data.CertificateId.ValueString(),
 		Tenant:                  data.Tenant.ValueString(),
 		AzureEnvironmentName:    data.AzureEnvironmentName.ValueString(),
@@ -214,6 +224,7 @@ func (r *credentialAzureServicePrincipalResource) Create(ctx context.Context, re
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.recordSecretFingerprint(ctx, &resp.State)...)
 }
 
 // Read is called when the provider must read resource values in order
@@ -253,12 +264,27 @@ func (r *credentialAzureServicePrincipalResource) Read(ctx context.Context, req
 	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), cred.ID))
 	data.Scope = types.StringValue(cred.Scope)
 	data.Description = types.StringValue(cred.Description)
+	data.SubscriptionId = types.StringValue(cred.Data.SubscriptionId)
+	data.ClientId = types.StringValue(cred.Data.ClientId)
+	data.Tenant = types.StringValue(cred.Data.Tenant)
+	data.AzureEnvironmentName = types.StringValue(cred.Data.AzureEnvironmentName)
+	data.ServiceManagementURL = types.StringValue(cred.Data.ServiceManagementURL)
+	data.AuthenticationEndpoint = types.StringValue(cred.Data.AuthenticationEndpoint)
+	data.ResourceManagerEndpoint = types.StringValue(cred.Data.ResourceManagerEndpoint)
+	data.GraphEndpoint = types.StringValue(cred.Data.GraphEndpoint)
+
+	// The certificate is only a reference to other credentials, so it is safe to read back
+	data.CertificateId = types.StringNull()
+	if cred.Data.CertificateId != "" {
+		data.CertificateId = types.StringValue(cred.Data.CertificateId)
+	}
 
 	// NOTE: We are NOT setting the password here, as the password returned by GetSingle is garbage
 	// Password only applies to Create/Update operations if the "password" property is non-empty
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.detectSecretDrift(ctx, &resp.State, "client_secret")...)
 }
 
 // Update is called to update the state of the resource. Config, planned
@@ -273,9 +299,27 @@ func (r *credentialAzureServicePrincipalResource) Update(ctx context.Context, re
 		return
 	}
 
+	// Relocate the credentials first if their folder or domain has changed
+	if err := r.moveCredential(ctx, req); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to move the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
 	cm := r.client.Credentials()
 	cm.Folder = formatFolderName(data.Folder.ValueString())
 
+	clientSecret, diags := writeOnlySecret(ctx, req.Config, data.ClientSecret, "client_secret")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	credData := AzureServicePrincipalCredentialsData{
 		SubscriptionId:          data.SubscriptionId.ValueString(),
 		ClientId:                data.ClientId.ValueString(),
@@ -295,8 +339,8 @@ func (r *credentialAzureServicePrincipalResource) Update(ctx context.Context, re
 	}
 
 	// Only enforce the password if it is non-empty
-	if data.ClientSecret.ValueString() != "" {
-		cred.Data.ClientSecret = data.ClientSecret.ValueString()
+	if clientSecret.ValueString() != "" {
+		cred.Data.ClientSecret = clientSecret.ValueString()
 	}
 
 	if data.CertificateId.ValueString() != "" {
@@ -315,8 +359,11 @@ func (r *credentialAzureServicePrincipalResource) Update(ctx context.Context, re
 		return
 	}
 
+	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), data.Name.ValueString()))
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.recordSecretFingerprint(ctx, &resp.State)...)
 }
 
 // Delete is called when the provider must delete the resource. Config
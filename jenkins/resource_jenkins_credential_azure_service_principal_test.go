@@ -42,6 +42,13 @@ func TestAccJenkinsCredentialAzureServicePrincipal_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("jenkins_credential_azure_service_principal.foo", "tenant", "456"),
 				),
 			},
+			{
+				ResourceName:            "jenkins_credential_azure_service_principal.foo",
+				ImportState:             true,
+				ImportStateId:           "_/bla",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_secret", "secret_fingerprint"},
+			},
 		},
 	})
 }
@@ -0,0 +1,492 @@
+package jenkins
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+const certificateUploadedKeyStoreSourceClass = "com.cloudbees.plugins.credentials.impl.CertificateCredentialsImpl$UploadedKeyStoreSource"
+
+// certificateReadScript prints the base64 encoded certificate belonging to the private key of the
+// keystore stored within Jenkins, given the base64 encoded folder, domain and credential ID.
+const certificateReadScript = `
+import com.cloudbees.plugins.credentials.CredentialsProvider
+import com.cloudbees.plugins.credentials.common.StandardCertificateCredentials
+import jenkins.model.Jenkins
+
+def decode = { new String(Base64.decoder.decode(it), 'UTF-8') }
+def folder = decode('%s')
+def domain = decode('%s')
+def id = decode('%s')
+
+def context = folder ? Jenkins.get().getItemByFullName(folder) : Jenkins.get()
+def cred = CredentialsProvider.lookupStores(context).findAll { it.context == context }.findResult { store ->
+	def d = store.getDomainByName(domain == '_' ? null : domain)
+	d ? store.getCredentials(d).find { it.id == id } : null
+}
+if (cred instanceof StandardCertificateCredentials) {
+	def keyStore = cred.keyStore
+	def alias = keyStore.aliases().toList().find { keyStore.isKeyEntry(it) }
+	if (alias) {
+		print(Base64.encoder.encodeToString(keyStore.getCertificate(alias).encoded))
+	}
+}`
+
+// CertificateCredentials struct representing credential for storing a PKCS#12 certificate keystore
+type CertificateCredentials struct {
+	XMLName        xml.Name                  `xml:"com.cloudbees.plugins.credentials.impl.CertificateCredentialsImpl"`
+	ID             string                    `xml:"id"`
+	Scope          string                    `xml:"scope"`
+	Description    string                    `xml:"description"`
+	KeyStoreSource CertificateKeyStoreSource `xml:"keyStoreSource"`
+	Password       string                    `xml:"password"`
+}
+
+// CertificateKeyStoreSource struct representing a keystore uploaded directly into Jenkins
+type CertificateKeyStoreSource struct {
+	Class         string `xml:"class,attr"`
+	KeyStoreBytes string `xml:"uploadedKeystoreBytes"`
+}
+
+type credentialCertificateResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Folder            types.String `tfsdk:"folder"`
+	Description       types.String `tfsdk:"description"`
+	Domain            types.String `tfsdk:"domain"`
+	Scope             types.String `tfsdk:"scope"`
+	KeyStore          types.String `tfsdk:"keystore"`
+	KeyStoreWO        types.String `tfsdk:"keystore_wo"`
+	KeyStoreWOVersion types.Int64  `tfsdk:"keystore_wo_version"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	Subject           types.String `tfsdk:"subject"`
+	Issuer            types.String `tfsdk:"issuer"`
+	NotAfter          types.String `tfsdk:"not_after"`
+	DetectSecretDrift types.Bool   `tfsdk:"detect_secret_drift"`
+	SecretFingerprint types.String `tfsdk:"secret_fingerprint"`
+}
+
+type credentialCertificateResource struct {
+	*resourceHelper
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ resource.ResourceWithConfigure = &credentialCertificateResource{}
+var _ resource.ResourceWithModifyPlan = &credentialCertificateResource{}
+
+func newCredentialCertificateResource() resource.Resource {
+	return &credentialCertificateResource{
+		resourceHelper: newResourceHelper(),
+	}
+}
+
+// Metadata should return the full name of the resource.
+func (r *credentialCertificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_certificate"
+}
+
+// Schema should return the schema for this resource.
+func (r *credentialCertificateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manages a certificate credential within Jenkins, backed by an uploaded PKCS#12 keystore. This certificate may then be referenced within jobs that are created.
+
+The "subject", "issuer" and "not_after" properties are read back from Jenkins through the script console, which requires the Overall/Administer permission. Without it, they are only derived from the configured keystore.
+
+` + secretsWarning("keystore", "password"),
+		Attributes: r.schemaCredential(r.schemaSecrets(map[string]schema.Attribute{
+			"keystore": schema.StringAttribute{
+				MarkdownDescription: "The PKCS#12 keystore, base64 encoded. It can be sourced directly from local file with filebase64(path) TF function or given directly.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of the keystore.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"subject": schema.StringAttribute{
+				MarkdownDescription: "The subject of the certificate within the keystore.",
+				Computed:            true,
+			},
+			"issuer": schema.StringAttribute{
+				MarkdownDescription: "The issuer of the certificate within the keystore.",
+				Computed:            true,
+			},
+			"not_after": schema.StringAttribute{
+				MarkdownDescription: "The time at which the certificate within the keystore expires, in RFC 3339 format.",
+				Computed:            true,
+			},
+		}, "keystore", "password")),
+	}
+}
+
+// ModifyPlan populates the certificate details from the planned keystore, so that they
+// are known ahead of the apply and an unreadable keystore is reported early. The keystore
+// is only parsed when it is being written, otherwise the details are kept from state.
+func (r *credentialCertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do upon deletion
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data credentialCertificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state credentialCertificateResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Write-only values are absent from the plan, so only their versions signal a change
+		if data.KeyStore.Equal(state.KeyStore) && data.KeyStoreWOVersion.Equal(state.KeyStoreWOVersion) &&
+			data.Password.Equal(state.Password) && data.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+			data.Subject = state.Subject
+			data.Issuer = state.Issuer
+			data.NotAfter = state.NotAfter
+			resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	keyStore, diags := writeOnlySecret(ctx, req.Config, data.KeyStore, "keystore")
+	resp.Diagnostics.Append(diags...)
+	password, diags := certificatePassword(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || keyStore.IsUnknown() || password.IsUnknown() {
+		return
+	}
+
+	cert, err := parseCertificateKeyStore(keyStore.ValueString(), password.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("keystore"),
+			"Invalid Keystore",
+			"The keystore could not be read. Ensure that it is a base64 encoded PKCS#12 file and that the password is correct.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	data.flattenCertificate(cert)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+}
+
+// Create is called when the provider must create a new resource. Config
+// and planned state values should be read from the
+// CreateRequest and new state values set on the CreateResponse.
+func (r *credentialCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data credentialCertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cm := r.client.Credentials()
+	cm.Folder = formatFolderName(data.Folder.ValueString())
+
+	// Validate that the folder exists
+	if err := folderExists(ctx, r.client, cm.Folder); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Folder",
+			fmt.Sprintf("An invalid folder name %q was specified. ", cm.Folder)+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	keyStore, diags := writeOnlySecret(ctx, req.Config, data.KeyStore, "keystore")
+	resp.Diagnostics.Append(diags...)
+	password, diags := certificatePassword(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cred := CertificateCredentials{
+		ID:          data.Name.ValueString(),
+		Scope:       data.Scope.ValueString(),
+		Description: data.Description.ValueString(),
+		KeyStoreSource: CertificateKeyStoreSource{
+			Class:         certificateUploadedKeyStoreSourceClass,
+			KeyStoreBytes: keyStore.ValueString(),
+		},
+		Password: password.ValueString(),
+	}
+
+	err := cm.Add(ctx, data.Domain.ValueString(), cred)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while creating the resource. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Convert from the API data model to the Terraform data model
+	// and set any unknown attribute values.
+	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), cred.ID))
+	resp.Diagnostics.Append(data.parseCertificate(keyStore.ValueString(), password.ValueString())...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.recordSecretFingerprint(ctx, &resp.State)...)
+}
+
+// Read is called when the provider must read resource values in order
+// to update state. Planned state values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (r *credentialCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data credentialCertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cm := r.client.Credentials()
+	cm.Folder = formatFolderName(data.Folder.ValueString())
+
+	cred := CertificateCredentials{}
+	err := cm.GetSingle(ctx, data.Domain.ValueString(), data.Name.ValueString(), &cred)
+	if err != nil {
+		if strings.HasSuffix(err.Error(), "404") {
+			// Job does not exist
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while parsing the resource read response. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), cred.ID))
+	data.Scope = types.StringValue(cred.Scope)
+	data.Description = types.StringValue(cred.Description)
+
+	// NOTE: We are NOT setting the keystore or password here, as the secrets returned by GetSingle are garbage
+	// Secrets only apply to Create/Update operations if the "keystore" property is non-empty
+	cert, err := r.getStoredCertificate(ctx, data.Folder.ValueString(), data.Domain.ValueString(), data.Name.ValueString())
+	if err != nil {
+		// The script console requires the Overall/Administer permission, so keep the details
+		// derived from the configured keystore when the certificate cannot be read
+		log.Printf("[DEBUG] jenkins::read - Certificate %q could not be read: %v", data.Name.ValueString(), err)
+	} else {
+		data.flattenCertificate(cert)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.detectSecretDrift(ctx, &resp.State, "keystore")...)
+}
+
+// Update is called to update the state of the resource. Config, planned
+// state, and prior state values should be read from the
+// UpdateRequest and new state values set on the UpdateResponse.
+func (r *credentialCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data credentialCertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Relocate the credentials first if their folder or domain has changed
+	if err := r.moveCredential(ctx, req); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to move the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	cm := r.client.Credentials()
+	cm.Folder = formatFolderName(data.Folder.ValueString())
+
+	keyStore, diags := writeOnlySecret(ctx, req.Config, data.KeyStore, "keystore")
+	resp.Diagnostics.Append(diags...)
+	password, diags := certificatePassword(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cred := CertificateCredentials{
+		ID:          data.Name.ValueString(),
+		Scope:       data.Scope.ValueString(),
+		Description: data.Description.ValueString(),
+		KeyStoreSource: CertificateKeyStoreSource{
+			Class: certificateUploadedKeyStoreSourceClass,
+		},
+	}
+
+	// Only enforce the keystore if it is non-empty
+	if keyStore.ValueString() != "" {
+		cred.KeyStoreSource.KeyStoreBytes = keyStore.ValueString()
+		cred.Password = password.ValueString()
+	}
+
+	err := cm.Update(ctx, data.Domain.ValueString(), data.Name.ValueString(), &cred)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), data.Name.ValueString()))
+	resp.Diagnostics.Append(data.parseCertificate(keyStore.ValueString(), password.ValueString())...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.recordSecretFingerprint(ctx, &resp.State)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *credentialCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data credentialCertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	cm := r.client.Credentials()
+	cm.Folder = formatFolderName(data.Folder.ValueString())
+
+	err := cm.Delete(ctx, data.Domain.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while deleting the resource. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// getStoredCertificate retrieves the certificate of the keystore stored within Jenkins. The
+// keystore is redacted from the credential configuration, so it is read through the script console.
+func (r *credentialCertificateResource) getStoredCertificate(ctx context.Context, folder, domain, id string) (*x509.Certificate, error) {
+	encode := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+	script := fmt.Sprintf(certificateReadScript, encode(strings.Join(extractFolders(folder), "/")), encode(domain), encode(id))
+
+	output, err := r.client.RunScript(ctx, script)
+	if err != nil {
+		return nil, err
+	}
+
+	output = strings.TrimSpace(output)
+	if output == "" {
+		return nil, fmt.Errorf("no certificate found for %q", id)
+	}
+
+	der, err := base64.StdEncoding.DecodeString(output)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode certificate: %w: %s", err, output)
+	}
+
+	return x509.ParseCertificate(der)
+}
+
+// certificatePassword returns the configured keystore password, or its write-only variant. The
+// configuration is used rather than the plan, as the plan defaults an unset password to empty.
+func certificatePassword(ctx context.Context, config tfsdk.Config) (types.String, diag.Diagnostics) {
+	var password types.String
+	diags := config.GetAttribute(ctx, path.Root("password"), &password)
+	if diags.HasError() {
+		return password, diags
+	}
+
+	password, d := writeOnlySecret(ctx, config, password, "password")
+	diags.Append(d...)
+	return password, diags
+}
+
+// parseCertificate populates the certificate details of the model from the given keystore.
+func (m *credentialCertificateResourceModel) parseCertificate(keyStore, password string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	cert, err := parseCertificateKeyStore(keyStore, password)
+	if err != nil {
+		diags.AddAttributeError(path.Root("keystore"), "Invalid Keystore", "The keystore could not be read.\n\nError: "+err.Error())
+		return diags
+	}
+
+	m.flattenCertificate(cert)
+	return diags
+}
+
+func (m *credentialCertificateResourceModel) flattenCertificate(cert *x509.Certificate) {
+	m.Subject = types.StringValue(cert.Subject.String())
+	m.Issuer = types.StringValue(cert.Issuer.String())
+	m.NotAfter = types.StringValue(cert.NotAfter.UTC().Format(time.RFC3339))
+}
+
+// parseCertificateKeyStore decodes the base64 encoded PKCS#12 keystore, returning the
+// certificate that belongs to its private key.
+func parseCertificateKeyStore(keystore, password string) (*x509.Certificate, error) {
+	data, err := base64.StdEncoding.DecodeString(keystore)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode base64 keystore: %w", err)
+	}
+
+	_, cert, _, err := pkcs12.DecodeChain(data, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return cert, nil
+}
@@ -0,0 +1,292 @@
+package jenkins
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+func TestAccJenkinsCredentialCertificate_basic(t *testing.T) {
+	var cred CertificateCredentials
+	notAfter := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	keystore := testAccCertificateKeyStore(t, "test-certificate", notAfter, "changeit")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		CheckDestroy:             testAccCheckJenkinsCredentialCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_credential_certificate foo {
+				  name = "test-certificate"
+				  keystore = "%s"
+				  password = "changeit"
+				}`, keystore),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_credential_certificate.foo", "id", "/test-certificate"),
+					resource.TestCheckResourceAttr("jenkins_credential_certificate.foo", "subject", "CN=test-certificate"),
+					resource.TestCheckResourceAttr("jenkins_credential_certificate.foo", "issuer", "CN=test-certificate"),
+					resource.TestCheckResourceAttr("jenkins_credential_certificate.foo", "not_after", "2030-01-01T00:00:00Z"),
+					testAccCheckJenkinsCredentialCertificateExists("jenkins_credential_certificate.foo", &cred),
+				),
+			},
+			{
+				// Update by adding description
+				Config: fmt.Sprintf(`
+				resource jenkins_credential_certificate foo {
+				  name = "test-certificate"
+				  description = "new-description"
+				  keystore = "%s"
+				  password = "changeit"
+				}`, keystore),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJenkinsCredentialCertificateExists("jenkins_credential_certificate.foo", &cred),
+					resource.TestCheckResourceAttr("jenkins_credential_certificate.foo", "description", "new-description"),
+				),
+			},
+			{
+				// The certificate details are read back from Jenkins upon import
+				ResourceName:            "jenkins_credential_certificate.foo",
+				ImportState:             true,
+				ImportStateId:           "_/test-certificate",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"keystore", "password", "secret_fingerprint"},
+			},
+		},
+	})
+}
+
+func TestAccJenkinsCredentialCertificate_writeOnly(t *testing.T) {
+	var cred CertificateCredentials
+	notAfter := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	keystore := testAccCertificateKeyStore(t, "test-certificate", notAfter, "changeit")
+	rotated := testAccCertificateKeyStore(t, "test-certificate-rotated", notAfter, "rotated")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckJenkinsCredentialCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_credential_certificate foo {
+				  name = "test-certificate"
+				  keystore_wo = "%s"
+				  keystore_wo_version = 1
+				  password_wo = "changeit"
+				  password_wo_version = 1
+				}`, keystore),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJenkinsCredentialCertificateExists("jenkins_credential_certificate.foo", &cred),
+					resource.TestCheckResourceAttr("jenkins_credential_certificate.foo", "subject", "CN=test-certificate"),
+					resource.TestCheckResourceAttr("jenkins_credential_certificate.foo", "password", ""),
+					resource.TestCheckNoResourceAttr("jenkins_credential_certificate.foo", "password_wo"),
+					resource.TestCheckResourceAttr("jenkins_credential_certificate.foo", "password_wo_version", "1"),
+				),
+			},
+			{
+				// Update by rotating the keystore along with its password
+				Config: fmt.Sprintf(`
+				resource jenkins_credential_certificate foo {
+				  name = "test-certificate"
+				  keystore_wo = "%s"
+				  keystore_wo_version = 2
+				  password_wo = "rotated"
+				  password_wo_version = 2
+				}`, rotated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJenkinsCredentialCertificateExists("jenkins_credential_certificate.foo", &cred),
+					resource.TestCheckResourceAttr("jenkins_credential_certificate.foo", "subject", "CN=test-certificate-rotated"),
+					resource.TestCheckResourceAttr("jenkins_credential_certificate.foo", "password_wo_version", "2"),
+				),
+			},
+			{
+				// Changing the keystore without bumping its version is not planned
+				Config: fmt.Sprintf(`
+				resource jenkins_credential_certificate foo {
+				  name = "test-certificate"
+				  keystore_wo = "%s"
+				  keystore_wo_version = 2
+				  password_wo = "rotated"
+				  password_wo_version = 2
+				}`, keystore),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func Test_parseCertificateKeyStore(t *testing.T) {
+	notAfter := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	keystore := testAccCertificateKeyStore(t, "example.com", notAfter, "changeit")
+
+	cert, err := parseCertificateKeyStore(keystore, "changeit")
+	if err != nil {
+		t.Fatalf("parseCertificateKeyStore() error = %v", err)
+	}
+	if got, want := cert.Subject.String(), "CN=example.com"; got != want {
+		t.Errorf("parseCertificateKeyStore() subject = %q, want %q", got, want)
+	}
+	if !cert.NotAfter.Equal(notAfter) {
+		t.Errorf("parseCertificateKeyStore() not after = %s, want %s", cert.NotAfter, notAfter)
+	}
+
+	if _, err := parseCertificateKeyStore(keystore, "wrong"); err == nil {
+		t.Errorf("parseCertificateKeyStore() expected error for incorrect password")
+	}
+	if _, err := parseCertificateKeyStore("not base64!", "changeit"); err == nil {
+		t.Errorf("parseCertificateKeyStore() expected error for invalid encoding")
+	}
+}
+
+func Test_credentialCertificateResource_getStoredCertificate(t *testing.T) {
+	notAfter := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	keystore := testAccCertificateKeyStore(t, "example.com", notAfter, "changeit")
+	cert, err := parseCertificateKeyStore(keystore, "changeit")
+	if err != nil {
+		t.Fatalf("parseCertificateKeyStore() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		output  string
+		wantErr bool
+	}{
+		{name: "found", output: base64.StdEncoding.EncodeToString(cert.Raw) + "\n"},
+		{name: "missing", output: "", wantErr: true},
+		{name: "invalid", output: "groovy.lang.MissingPropertyException", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var script string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				script = r.PostForm.Get("script")
+				_, _ = w.Write([]byte(tt.output))
+			}))
+			defer server.Close()
+
+			r := &credentialCertificateResource{resourceHelper: newResourceHelper()}
+			r.client = newJenkinsClient(&Config{ServerURL: server.URL})
+
+			got, err := r.getStoredCertificate(context.Background(), "/job/a/job/b", "_", "example")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getStoredCertificate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !strings.Contains(script, base64.StdEncoding.EncodeToString([]byte("a/b"))) {
+				t.Errorf("getStoredCertificate() script = %s, want it to reference folder a/b", script)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if got.Subject.String() != "CN=example.com" || !got.NotAfter.Equal(notAfter) {
+				t.Errorf("getStoredCertificate() = %s expiring %s, want CN=example.com expiring %s", got.Subject, got.NotAfter, notAfter)
+			}
+		})
+	}
+}
+
+// testAccCertificateKeyStore generates a base64 encoded PKCS#12 keystore holding a self-signed certificate.
+func testAccCertificateKeyStore(t *testing.T, commonName string, notAfter time.Time, password string) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Unable to generate private key: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    notAfter.AddDate(-1, 0, 0),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Unable to create certificate: %s", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Unable to parse certificate: %s", err)
+	}
+
+	pfx, err := pkcs12.Modern.Encode(key, cert, nil, password)
+	if err != nil {
+		t.Fatalf("Unable to encode keystore: %s", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(pfx)
+}
+
+func testAccCheckJenkinsCredentialCertificateExists(resourceName string, cred *CertificateCredentials) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ctx := context.Background()
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return errors.New(resourceName + " not found")
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+
+		manager := testAccClient.Credentials()
+		manager.Folder = formatFolderName(rs.Primary.Attributes["folder"])
+		err := manager.GetSingle(ctx, rs.Primary.Attributes["domain"], rs.Primary.Attributes["name"], cred)
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve credentials for %s - %s: %w", rs.Primary.Attributes["folder"], rs.Primary.Attributes["name"], err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckJenkinsCredentialCertificateDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jenkins_credential_certificate" {
+			continue
+		} else if _, ok := rs.Primary.Meta["name"]; !ok {
+			continue
+		}
+
+		cred := CertificateCredentials{}
+		manager := testAccClient.Credentials()
+		manager.Folder = formatFolderName(rs.Primary.Meta["folder"].(string))
+		err := manager.GetSingle(ctx, rs.Primary.Meta["domain"].(string), rs.Primary.Meta["name"].(string), &cred)
+		if err == nil {
+			return fmt.Errorf("Credentials still exists: %s - %s", rs.Primary.Attributes["folder"], rs.Primary.Attributes["name"])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,282 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	jenkins "github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type credentialDockerServerResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Folder              types.String `tfsdk:"folder"`
+	Description         types.String `tfsdk:"description"`
+	Domain              types.String `tfsdk:"domain"`
+	Scope               types.String `tfsdk:"scope"`
+	ClientKey           types.String `tfsdk:"client_key"`
+	ClientKeyWO         types.String `tfsdk:"client_key_wo"`
+	ClientKeyWOVersion  types.Int64  `tfsdk:"client_key_wo_version"`
+	ClientCertificate   types.String `tfsdk:"client_certificate"`
+	ServerCaCertificate types.String `tfsdk:"server_ca_certificate"`
+	DetectSecretDrift   types.Bool   `tfsdk:"detect_secret_drift"`
+	SecretFingerprint   types.String `tfsdk:"secret_fingerprint"`
+}
+
+type credentialDockerServerResource struct {
+	*resourceHelper
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ resource.ResourceWithConfigure = &credentialDockerServerResource{}
+
+func newCredentialDockerServerResource() resource.Resource {
+	return &credentialDockerServerResource{
+		resourceHelper: newResourceHelper(),
+	}
+}
+
+// Metadata should return the full name of the resource.
+func (r *credentialDockerServerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_docker_server"
+}
+
+// Schema should return the schema for this resource.
+func (r *credentialDockerServerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manages a Docker server credential within Jenkins, used to authenticate against a remote Docker daemon over TLS. This credential may then be referenced within jobs that are created.
+
+~> The Jenkins installation that uses this resource is expected to have the [Docker Commons Plugin](https://plugins.jenkins.io/docker-commons/) installed in their system.
+
+` + secretsWarning("client_key"),
+		Attributes: r.schemaCredential(r.schemaSecrets(map[string]schema.Attribute{
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client private key, can be given as string or read from file with 'file()' terraform function.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"client_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate, can be given as string or read from file with 'file()' terraform function.",
+				Required:            true,
+			},
+			"server_ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded certificate of the CA used to verify the Docker server. If not set the server certificate is not verified against a specific CA.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		}, "client_key")),
+	}
+}
+
+// Create is called when the provider must create a new resource. Config
+// and planned state values should be read from the
+// CreateRequest and new state values set on the CreateResponse.
+func (r *credentialDockerServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data credentialDockerServerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cm := r.client.Credentials()
+	cm.Folder = formatFolderName(data.Folder.ValueString())
+
+	// Validate that the folder exists
+	if err := folderExists(ctx, r.client, cm.Folder); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Folder",
+			fmt.Sprintf("An invalid folder name %q was specified. ", cm.Folder)+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	clientKey, diags := writeOnlySecret(ctx, req.Config, data.ClientKey, "client_key")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cred := jenkins.DockerServerCredentials{
+		ID:                  data.Name.ValueString(),
+		Scope:               data.Scope.ValueString(),
+		Description:         data.Description.ValueString(),
+		ClientKey:           clientKey.ValueString(),
+		ClientCertificate:   data.ClientCertificate.ValueString(),
+		ServerCaCertificate: data.ServerCaCertificate.ValueString(),
+	}
+
+	err := cm.Add(ctx, data.Domain.ValueString(), cred)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while creating the resource. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Convert from the API data model to the Terraform data model
+	// and set any unknown attribute values.
+	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), cred.ID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.recordSecretFingerprint(ctx, &resp.State)...)
+}
+
+// Read is called when the provider must read resource values in order
+// to update state. Planned state values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (r *credentialDockerServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data credentialDockerServerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cm := r.client.Credentials()
+	cm.Folder = formatFolderName(data.Folder.ValueString())
+
+	cred := jenkins.DockerServerCredentials{}
+	err := cm.GetSingle(ctx, data.Domain.ValueString(), data.Name.ValueString(), &cred)
+	if err != nil {
+		if strings.HasSuffix(err.Error(), "404") {
+			// Job does not exist
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while parsing the resource read response. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), cred.ID))
+	data.Scope = types.StringValue(cred.Scope)
+	data.Description = types.StringValue(cred.Description)
+
+	// Jenkins trims the certificates it stores, so only report a change if the content differs
+	if strings.TrimSpace(data.ClientCertificate.ValueString()) != cred.ClientCertificate {
+		data.ClientCertificate = types.StringValue(cred.ClientCertificate)
+	}
+	if strings.TrimSpace(data.ServerCaCertificate.ValueString()) != cred.ServerCaCertificate {
+		data.ServerCaCertificate = types.StringValue(cred.ServerCaCertificate)
+	}
+
+	// NOTE: We are NOT setting the client key here, as the secrets returned by GetSingle are garbage
+	// Secret only applies to Create/Update operations
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.detectSecretDrift(ctx, &resp.State, "client_key")...)
+}
+
+// Update is called to update the state of the resource. Config, planned
+// state, and prior state values should be read from the
+// UpdateRequest and new state values set on the UpdateResponse.
+func (r *credentialDockerServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data credentialDockerServerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Relocate the credentials first if their folder or domain has changed
+	if err := r.moveCredential(ctx, req); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to move the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	cm := r.client.Credentials()
+	cm.Folder = formatFolderName(data.Folder.ValueString())
+
+	clientKey, diags := writeOnlySecret(ctx, req.Config, data.ClientKey, "client_key")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cred := jenkins.DockerServerCredentials{
+		ID:                  data.Name.ValueString(),
+		Scope:               data.Scope.ValueString(),
+		Description:         data.Description.ValueString(),
+		ClientKey:           clientKey.ValueString(),
+		ClientCertificate:   data.ClientCertificate.ValueString(),
+		ServerCaCertificate: data.ServerCaCertificate.ValueString(),
+	}
+
+	err := cm.Update(ctx, data.Domain.ValueString(), data.Name.ValueString(), &cred)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), data.Name.ValueString()))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.recordSecretFingerprint(ctx, &resp.State)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *credentialDockerServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data credentialDockerServerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	cm := r.client.Credentials()
+	cm.Folder = formatFolderName(data.Folder.ValueString())
+
+	err := cm.Delete(ctx, data.Domain.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while deleting the resource. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
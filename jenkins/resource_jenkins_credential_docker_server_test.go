@@ -0,0 +1,99 @@
+package jenkins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	jenkins "github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccJenkinsCredentialDockerServer_basic(t *testing.T) {
+	var cred jenkins.DockerServerCredentials
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		CheckDestroy:             testAccCheckJenkinsCredentialDockerServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_credential_docker_server foo {
+				  name = "test-docker-server"
+				  client_key = "Some fake client key"
+				  client_certificate = "Some fake client certificate"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_credential_docker_server.foo", "id", "/test-docker-server"),
+					resource.TestCheckResourceAttr("jenkins_credential_docker_server.foo", "server_ca_certificate", ""),
+					testAccCheckJenkinsCredentialDockerServerExists("jenkins_credential_docker_server.foo", &cred),
+				),
+			},
+			{
+				// Update by rotating the client certificate and adding a server CA
+				Config: `
+				resource jenkins_credential_docker_server foo {
+				  name = "test-docker-server"
+				  client_key = "Some other fake client key"
+				  client_certificate = "Some other fake client certificate"
+				  server_ca_certificate = "Some fake CA certificate"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJenkinsCredentialDockerServerExists("jenkins_credential_docker_server.foo", &cred),
+					resource.TestCheckResourceAttr("jenkins_credential_docker_server.foo", "client_key", "Some other fake client key"),
+					resource.TestCheckResourceAttr("jenkins_credential_docker_server.foo", "client_certificate", "Some other fake client certificate"),
+					resource.TestCheckResourceAttr("jenkins_credential_docker_server.foo", "server_ca_certificate", "Some fake CA certificate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJenkinsCredentialDockerServerExists(resourceName string, cred *jenkins.DockerServerCredentials) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ctx := context.Background()
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return errors.New(resourceName + " not found")
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+
+		manager := testAccClient.Credentials()
+		manager.Folder = formatFolderName(rs.Primary.Attributes["folder"])
+		err := manager.GetSingle(ctx, rs.Primary.Attributes["domain"], rs.Primary.Attributes["name"], cred)
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve credentials for %s - %s: %w", rs.Primary.Attributes["folder"], rs.Primary.Attributes["name"], err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckJenkinsCredentialDockerServerDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jenkins_credential_docker_server" {
+			continue
+		} else if _, ok := rs.Primary.Meta["name"]; !ok {
+			continue
+		}
+
+		cred := jenkins.DockerServerCredentials{}
+		manager := testAccClient.Credentials()
+		manager.Folder = formatFolderName(rs.Primary.Meta["folder"].(string))
+		err := manager.GetSingle(ctx, rs.Primary.Meta["domain"].(string), rs.Primary.Meta["name"].(string), &cred)
+		if err == nil {
+			return fmt.Errorf("Credentials still exists: %s - %s", rs.Primary.Attributes["folder"], rs.Primary.Attributes["name"])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,386 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type credentialDomainResourceModel struct {
+	ID             types.String                         `tfsdk:"id"`
+	Name           types.String                         `tfsdk:"name"`
+	Folder         types.String                         `tfsdk:"folder"`
+	Description    types.String                         `tfsdk:"description"`
+	Specifications *credentialDomainSpecificationsModel `tfsdk:"specifications"`
+}
+
+type credentialDomainSpecificationsModel struct {
+	Hostname *credentialDomainHostnameModel `tfsdk:"hostname"`
+	Scheme   *credentialDomainSchemeModel   `tfsdk:"scheme"`
+	Path     *credentialDomainPathModel     `tfsdk:"path"`
+}
+
+type credentialDomainHostnameModel struct {
+	Includes types.String `tfsdk:"includes"`
+	Excludes types.String `tfsdk:"excludes"`
+}
+
+type credentialDomainSchemeModel struct {
+	Schemes types.String `tfsdk:"schemes"`
+}
+
+type credentialDomainPathModel struct {
+	Includes      types.String `tfsdk:"includes"`
+	Excludes      types.String `tfsdk:"excludes"`
+	CaseSensitive types.Bool   `tfsdk:"case_sensitive"`
+}
+
+type credentialDomainResource struct {
+	*resourceHelper
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ resource.ResourceWithConfigure = &credentialDomainResource{}
+var _ resource.ResourceWithImportState = &credentialDomainResource{}
+
+func newCredentialDomainResource() resource.Resource {
+	return &credentialDomainResource{
+		resourceHelper: newResourceHelper(),
+	}
+}
+
+// Metadata should return the full name of the resource.
+func (r *credentialDomainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_domain"
+}
+
+// Schema should return the schema for this resource.
+func (r *credentialDomainResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manages a credentials domain within Jenkins. Credentials may then be placed into the domain through their ` + "`domain`" + ` attribute, restricting them to the services matched by its specifications.
+
+~> Deleting a domain also deletes all credentials stored within it.`,
+		Attributes: r.schema(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The full canonical path of the domain, e.g. `/job/folder-name/domain-name`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the domain, e.g. `github.com`. This cannot be changed once set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A human readable description of the domain.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Managed by Terraform"),
+			},
+			"specifications": schema.SingleNestedAttribute{
+				MarkdownDescription: "The specifications that restrict which services the credentials of the domain apply to. If not set, the credentials apply to all services.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"hostname": schema.SingleNestedAttribute{
+						MarkdownDescription: "Restricts the domain to matching hostnames.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"includes": schema.StringAttribute{
+								MarkdownDescription: "A comma separated list of hostnames to include, which may contain `*` wildcards, e.g. `github.com, *.github.com`.",
+								Optional:            true,
+								Computed:            true,
+								Default:             stringdefault.StaticString(""),
+							},
+							"excludes": schema.StringAttribute{
+								MarkdownDescription: "A comma separated list of hostnames to exclude, which may contain `*` wildcards.",
+								Optional:            true,
+								Computed:            true,
+								Default:             stringdefault.StaticString(""),
+							},
+						},
+					},
+					"scheme": schema.SingleNestedAttribute{
+						MarkdownDescription: "Restricts the domain to matching URI schemes.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"schemes": schema.StringAttribute{
+								MarkdownDescription: "A comma separated list of URI schemes, e.g. `https, ssh`.",
+								Required:            true,
+							},
+						},
+					},
+					"path": schema.SingleNestedAttribute{
+						MarkdownDescription: "Restricts the domain to matching URI paths.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"includes": schema.StringAttribute{
+								MarkdownDescription: "A comma separated list of paths to include, which may contain `*` wildcards, e.g. `/my-org/*`.",
+								Optional:            true,
+								Computed:            true,
+								Default:             stringdefault.StaticString(""),
+							},
+							"excludes": schema.StringAttribute{
+								MarkdownDescription: "A comma separated list of paths to exclude, which may contain `*` wildcards.",
+								Optional:            true,
+								Computed:            true,
+								Default:             stringdefault.StaticString(""),
+							},
+							"case_sensitive": schema.BoolAttribute{
+								MarkdownDescription: "Whether paths are matched case sensitively. Defaults to `true`.",
+								Optional:            true,
+								Computed:            true,
+								Default:             booldefault.StaticBool(true),
+							},
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+// Create is called when the provider must create a new resource. Config
+// and planned state values should be read from the
+// CreateRequest and new state values set on the CreateResponse.
+func (r *credentialDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data credentialDomainResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validate that the folder exists
+	folderName := formatFolderName(data.Folder.ValueString())
+	if err := folderExists(ctx, r.client, folderName); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Folder",
+			fmt.Sprintf("An invalid folder name %q was specified. ", folderName)+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	domain := credentialDomain{}
+	data.expand(&domain)
+
+	err := r.client.CreateCredentialDomain(ctx, data.Folder.ValueString(), &domain)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while creating the resource. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Convert from the API data model to the Terraform data model
+	// and set any unknown attribute values.
+	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), domain.Name))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called when the provider must read resource values in order
+// to update state. Planned state values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (r *credentialDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data credentialDomainResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, err := r.client.GetCredentialDomain(ctx, data.Folder.ValueString(), data.Name.ValueString())
+	if err != nil {
+		if strings.HasSuffix(err.Error(), "404") {
+			// Domain does not exist
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while parsing the resource read response. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), domain.Name))
+	data.flatten(domain)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is called to update the state of the resource. Config, planned
+// state, and prior state values should be read from the
+// UpdateRequest and new state values set on the UpdateResponse.
+func (r *credentialDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data credentialDomainResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Start from the existing domain so that unmanaged specifications are retained
+	domain, err := r.client.GetCredentialDomain(ctx, data.Folder.ValueString(), data.Name.ValueString())
+	if err == nil {
+		data.expand(domain)
+		err = r.client.UpdateCredentialDomain(ctx, data.Folder.ValueString(), data.Name.ValueString(), domain)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *credentialDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data credentialDomainResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	err := r.client.DeleteCredentialDomain(ctx, data.Folder.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while deleting the resource. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ImportState is called when performing import operations of existing resources.
+func (r *credentialDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	splitID := strings.Split(strings.Trim(req.ID, "/"), "/")
+	if splitID[len(splitID)-1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: \"[<folder>/]<name>\". Got: %q", req.ID),
+		)
+		return
+	}
+
+	name := splitID[len(splitID)-1]
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+
+	// Use the canonical folder path, as exported by jenkins_folder, leaving it unset for the global store
+	folder := formatFolderID(extractFolders(strings.Join(splitID[0:len(splitID)-1], "/")))
+	if folder != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("folder"), folder)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), generateCredentialID(folder, name))...)
+}
+
+// expand applies the Terraform data model onto the given domain.
+func (m *credentialDomainResourceModel) expand(domain *credentialDomain) {
+	domain.Name = m.Name.ValueString()
+	domain.Description = m.Description.ValueString()
+
+	specs := m.Specifications
+	if specs == nil {
+		specs = &credentialDomainSpecificationsModel{}
+	}
+
+	domain.Specifications.Hostname = nil
+	if specs.Hostname != nil {
+		domain.Specifications.Hostname = &credentialDomainHostnameSpecification{
+			Includes: specs.Hostname.Includes.ValueString(),
+			Excludes: specs.Hostname.Excludes.ValueString(),
+		}
+	}
+
+	domain.Specifications.Scheme = nil
+	if specs.Scheme != nil {
+		domain.Specifications.Scheme = &credentialDomainSchemeSpecification{
+			Schemes: specs.Scheme.Schemes.ValueString(),
+		}
+	}
+
+	domain.Specifications.Path = nil
+	if specs.Path != nil {
+		domain.Specifications.Path = &credentialDomainPathSpecification{
+			Includes:      specs.Path.Includes.ValueString(),
+			Excludes:      specs.Path.Excludes.ValueString(),
+			CaseSensitive: specs.Path.CaseSensitive.ValueBool(),
+		}
+	}
+}
+
+// flatten populates the Terraform data model from the given domain.
+func (m *credentialDomainResourceModel) flatten(domain *credentialDomain) {
+	m.Name = types.StringValue(domain.Name)
+	m.Description = types.StringValue(domain.Description)
+
+	specs := &credentialDomainSpecificationsModel{}
+	if s := domain.Specifications.Hostname; s != nil {
+		specs.Hostname = &credentialDomainHostnameModel{
+			Includes: types.StringValue(s.Includes),
+			Excludes: types.StringValue(s.Excludes),
+		}
+	}
+	if s := domain.Specifications.Scheme; s != nil {
+		specs.Scheme = &credentialDomainSchemeModel{
+			Schemes: types.StringValue(s.Schemes),
+		}
+	}
+	if s := domain.Specifications.Path; s != nil {
+		specs.Path = &credentialDomainPathModel{
+			Includes:      types.StringValue(s.Includes),
+			Excludes:      types.StringValue(s.Excludes),
+			CaseSensitive: types.BoolValue(s.CaseSensitive),
+		}
+	}
+
+	// Retain an empty specifications block if one was configured, as it is equivalent to no specifications
+	if m.Specifications != nil || specs.Hostname != nil || specs.Scheme != nil || specs.Path != nil {
+		m.Specifications = specs
+	}
+}
@@ -0,0 +1,193 @@
+package jenkins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccJenkinsCredentialDomain_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		CheckDestroy:             testAccCheckJenkinsCredentialDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_credential_domain foo {
+				  name = "tf-acc-test-%s"
+
+				  specifications = {
+				    hostname = {
+				      includes = "github.com, *.github.com"
+				    }
+				  }
+				}
+
+				resource jenkins_credential_secret_text foo {
+				  name   = "test-secret-text"
+				  domain = jenkins_credential_domain.foo.name
+				  secret = "very-secret"
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_credential_domain.foo", "id", "/tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("jenkins_credential_domain.foo", "specifications.hostname.includes", "github.com, *.github.com"),
+					testAccCheckJenkinsCredentialDomainExists("jenkins_credential_domain.foo"),
+				),
+			},
+			{
+				// Update by adding description and specifications
+				Config: fmt.Sprintf(`
+				resource jenkins_credential_domain foo {
+				  name        = "tf-acc-test-%s"
+				  description = "new-description"
+
+				  specifications = {
+				    hostname = {
+				      includes = "github.com, *.github.com"
+				    }
+				    scheme = {
+				      schemes = "https"
+				    }
+				    path = {
+				      includes       = "/example/*"
+				      case_sensitive = false
+				    }
+				  }
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_credential_domain.foo", "description", "new-description"),
+					resource.TestCheckResourceAttr("jenkins_credential_domain.foo", "specifications.scheme.schemes", "https"),
+					resource.TestCheckResourceAttr("jenkins_credential_domain.foo", "specifications.path.case_sensitive", "false"),
+					testAccCheckJenkinsCredentialDomainExists("jenkins_credential_domain.foo"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJenkinsCredentialDomain_folder(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckJenkinsCredentialDomainDestroy,
+			testAccCheckJenkinsFolderDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_folder foo {
+					name = "tf-acc-test-%s"
+					description = "Terraform acceptance testing"
+				}
+
+				resource jenkins_credential_domain foo {
+				  name   = "github.com"
+				  folder = jenkins_folder.foo.id
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_credential_domain.foo", "id", "/job/tf-acc-test-"+randString+"/github.com"),
+					testAccCheckJenkinsCredentialDomainExists("jenkins_credential_domain.foo"),
+				),
+			},
+			{
+				// Import through a bare folder path, which is canonicalized to match the folder ID
+				ResourceName:      "jenkins_credential_domain.foo",
+				ImportState:       true,
+				ImportStateId:     "tf-acc-test-" + randString + "/github.com",
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_folder foo {
+					name = "tf-acc-test-%s"
+					description = "Terraform acceptance testing"
+				}
+
+				resource jenkins_credential_domain foo {
+				  name           = "github.com"
+				  folder         = jenkins_folder.foo.id
+				  specifications = {}
+				}`, randString),
+			},
+			{
+				// An empty specifications block must not produce a perpetual diff
+				Config: fmt.Sprintf(`
+				resource jenkins_folder foo {
+					name = "tf-acc-test-%s"
+					description = "Terraform acceptance testing"
+				}
+
+				resource jenkins_credential_domain foo {
+				  name           = "github.com"
+				  folder         = jenkins_folder.foo.id
+				  specifications = {}
+				}`, randString),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func Test_credentialDomainResourceModel_flatten(t *testing.T) {
+	m := &credentialDomainResourceModel{Specifications: &credentialDomainSpecificationsModel{}}
+	m.flatten(&credentialDomain{Name: "github.com"})
+	if m.Specifications == nil || m.Specifications.Hostname != nil || m.Specifications.Scheme != nil || m.Specifications.Path != nil {
+		t.Errorf("flatten() specifications = %+v, want an empty block", m.Specifications)
+	}
+
+	m = &credentialDomainResourceModel{}
+	m.flatten(&credentialDomain{Name: "github.com"})
+	if m.Specifications != nil {
+		t.Errorf("flatten() specifications = %+v, want nil", m.Specifications)
+	}
+}
+
+func testAccCheckJenkinsCredentialDomainExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ctx := context.Background()
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return errors.New(resourceName + " not found")
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+
+		_, err := testAccClient.GetCredentialDomain(ctx, rs.Primary.Attributes["folder"], rs.Primary.Attributes["name"])
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve credential domain for %s - %s: %w", rs.Primary.Attributes["folder"], rs.Primary.Attributes["name"], err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckJenkinsCredentialDomainDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jenkins_credential_domain" {
+			continue
+		}
+
+		_, err := testAccClient.GetCredentialDomain(ctx, rs.Primary.Attributes["folder"], rs.Primary.Attributes["name"])
+		if err == nil {
+			return fmt.Errorf("Credential domain still exists: %s - %s", rs.Primary.Attributes["folder"], rs.Primary.Attributes["name"])
+		}
+	}
+
+	return nil
+}
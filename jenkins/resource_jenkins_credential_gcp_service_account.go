@@ -0,0 +1,387 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	gcpJSONServiceAccountConfigClass = "com.google.jenkins.plugins.credentials.oauth.JsonServiceAccountConfig"
+	gcpServiceAccountKeyFilename     = "key.json"
+)
+
+// GCPServiceAccountCredentials struct representing credential for storing a Google Cloud service account JSON key
+type GCPServiceAccountCredentials struct {
+	XMLName              xml.Name                                  `xml:"com.google.jenkins.plugins.credentials.oauth.GoogleRobotPrivateKeyCredentials"`
+	ID                   string                                    `xml:"id"`
+	Scope                string                                    `xml:"scope"`
+	Description          string                                    `xml:"description"`
+	ProjectID            string                                    `xml:"projectId"`
+	ServiceAccountConfig GCPServiceAccountCredentialsAccountConfig `xml:"serviceAccountConfig"`
+}
+
+// GCPServiceAccountCredentialsAccountConfig struct representing a JSON key uploaded directly into Jenkins
+type GCPServiceAccountCredentialsAccountConfig struct {
+	Class         string `xml:"class,attr"`
+	Filename      string `xml:"filename"`
+	SecretJSONKey string `xml:"secretJsonKey"`
+}
+
+// gcpServiceAccountKey represents the fields of a Google Cloud service account JSON key that are exposed.
+type gcpServiceAccountKey struct {
+	ProjectID   string `json:"project_id"`
+	ClientEmail string `json:"client_email"`
+}
+
+type credentialGCPServiceAccountResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Folder            types.String `tfsdk:"folder"`
+	Description       types.String `tfsdk:"description"`
+	Domain            types.String `tfsdk:"domain"`
+	Scope             types.String `tfsdk:"scope"`
+	JSONKey           types.String `tfsdk:"json_key"`
+	JSONKeyWO         types.String `tfsdk:"json_key_wo"`
+	JSONKeyWOVersion  types.Int64  `tfsdk:"json_key_wo_version"`
+	ProjectID         types.String `tfsdk:"project_id"`
+	ClientEmail       types.String `tfsdk:"client_email"`
+	DetectSecretDrift types.Bool   `tfsdk:"detect_secret_drift"`
+	SecretFingerprint types.String `tfsdk:"secret_fingerprint"`
+}
+
+type credentialGCPServiceAccountResource struct {
+	*resourceHelper
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ resource.ResourceWithConfigure = &credentialGCPServiceAccountResource{}
+var _ resource.ResourceWithModifyPlan = &credentialGCPServiceAccountResource{}
+
+func newCredentialGCPServiceAccountResource() resource.Resource {
+	return &credentialGCPServiceAccountResource{
+		resourceHelper: newResourceHelper(),
+	}
+}
+
+// Metadata should return the full name of the resource.
+func (r *credentialGCPServiceAccountResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_gcp_service_account"
+}
+
+// Schema should return the schema for this resource.
+func (r *credentialGCPServiceAccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manages a Google Cloud service account credential within Jenkins, backed by a JSON key. This credential may then be referenced within jobs that are created.
+
+` + secretsWarning("json_key") + `
+
+~> The Jenkins installation that uses this resource is expected to have the [Google OAuth Credentials Plugin](https://plugins.jenkins.io/google-oauth-plugin/) installed in their system.`,
+		Attributes: r.schemaCredential(r.schemaSecrets(map[string]schema.Attribute{
+			"json_key": schema.StringAttribute{
+				MarkdownDescription: "The JSON key of the service account. It can be sourced directly from local file with file(path) TF function, or from the base64 decoded `private_key` of a `google_service_account_key` resource.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project the service account belongs to, as read from the JSON key.",
+				Computed:            true,
+			},
+			"client_email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the service account, as read from the JSON key.",
+				Computed:            true,
+			},
+		}, "json_key")),
+	}
+}
+
+// ModifyPlan populates the service account details from the planned JSON key, so that they
+// are known ahead of the apply and an unreadable key is reported early.
+func (r *credentialGCPServiceAccountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do upon deletion
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data credentialGCPServiceAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jsonKey, diags := writeOnlySecret(ctx, req.Config, data.JSONKey, "json_key")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || jsonKey.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.parseJSONKey(jsonKey.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+}
+
+// Create is called when the provider must create a new resource. Config
+// and planned state values should be read from the
+// CreateRequest and new state values set on the CreateResponse.
+func (r *credentialGCPServiceAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data credentialGCPServiceAccountResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cm := r.client.Credentials()
+	cm.Folder = formatFolderName(data.Folder.ValueString())
+
+	// Validate that the folder exists
+	if err := folderExists(ctx, r.client, cm.Folder); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Folder",
+			fmt.Sprintf("An invalid folder name %q was specified. ", cm.Folder)+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	jsonKey, diags := writeOnlySecret(ctx, req.Config, data.JSONKey, "json_key")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.parseJSONKey(jsonKey.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cred := GCPServiceAccountCredentials{
+		ID:          data.Name.ValueString(),
+		Scope:       data.Scope.ValueString(),
+		Description: data.Description.ValueString(),
+		ProjectID:   data.ProjectID.ValueString(),
+		ServiceAccountConfig: GCPServiceAccountCredentialsAccountConfig{
+			Class:         gcpJSONServiceAccountConfigClass,
+			Filename:      gcpServiceAccountKeyFilename,
+			SecretJSONKey: base64.StdEncoding.EncodeToString([]byte(jsonKey.ValueString())),
+		},
+	}
+
+	err := cm.Add(ctx, data.Domain.ValueString(), cred)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while creating the resource. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Convert from the API data model to the Terraform data model
+	// and set any unknown attribute values.
+	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), cred.ID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.recordSecretFingerprint(ctx, &resp.State)...)
+}
+
+// Read is called when the provider must read resource values in order
+// to update state. Planned state values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (r *credentialGCPServiceAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data credentialGCPServiceAccountResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cm := r.client.Credentials()
+	cm.Folder = formatFolderName(data.Folder.ValueString())
+
+	cred := GCPServiceAccountCredentials{}
+	err := cm.GetSingle(ctx, data.Domain.ValueString(), data.Name.ValueString(), &cred)
+	if err != nil {
+		if strings.HasSuffix(err.Error(), "404") {
+			// Job does not exist
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while parsing the resource read response. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), cred.ID))
+	data.Scope = types.StringValue(cred.Scope)
+	data.Description = types.StringValue(cred.Description)
+	data.ProjectID = types.StringValue(cred.ProjectID)
+
+	// NOTE: We are NOT setting the JSON key or client email here, as the secret returned by GetSingle is garbage
+	// Secret only applies to Create/Update operations if the "json_key" property is non-empty
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.detectSecretDrift(ctx, &resp.State, "json_key")...)
+}
+
+// Update is called to update the state of the resource. Config, planned
+// state, and prior state values should be read from the
+// UpdateRequest and new state values set on the UpdateResponse.
+func (r *credentialGCPServiceAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data credentialGCPServiceAccountResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Relocate the credentials first if their folder or domain has changed
+	if err := r.moveCredential(ctx, req); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to move the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	cm := r.client.Credentials()
+	cm.Folder = formatFolderName(data.Folder.ValueString())
+
+	jsonKey, diags := writeOnlySecret(ctx, req.Config, data.JSONKey, "json_key")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cred := GCPServiceAccountCredentials{
+		ID:          data.Name.ValueString(),
+		Scope:       data.Scope.ValueString(),
+		Description: data.Description.ValueString(),
+		ProjectID:   data.ProjectID.ValueString(),
+		ServiceAccountConfig: GCPServiceAccountCredentialsAccountConfig{
+			Class:    gcpJSONServiceAccountConfigClass,
+			Filename: gcpServiceAccountKeyFilename,
+		},
+	}
+
+	// Only enforce the JSON key if it is non-empty
+	if jsonKey.ValueString() != "" {
+		resp.Diagnostics.Append(data.parseJSONKey(jsonKey.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		cred.ProjectID = data.ProjectID.ValueString()
+		cred.ServiceAccountConfig.SecretJSONKey = base64.StdEncoding.EncodeToString([]byte(jsonKey.ValueString()))
+	}
+
+	err := cm.Update(ctx, data.Domain.ValueString(), data.Name.ValueString(), &cred)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), data.Name.ValueString()))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.recordSecretFingerprint(ctx, &resp.State)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *credentialGCPServiceAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data credentialGCPServiceAccountResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	cm := r.client.Credentials()
+	cm.Folder = formatFolderName(data.Folder.ValueString())
+
+	err := cm.Delete(ctx, data.Domain.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while deleting the resource. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// parseJSONKey populates the service account details of the model from the given JSON key.
+func (m *credentialGCPServiceAccountResourceModel) parseJSONKey(jsonKey string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	key, err := parseGCPServiceAccountKey(jsonKey)
+	if err != nil {
+		diags.AddAttributeError(path.Root("json_key"), "Invalid JSON Key", "The service account JSON key could not be read.\n\nError: "+err.Error())
+		return diags
+	}
+
+	m.ProjectID = types.StringValue(key.ProjectID)
+	m.ClientEmail = types.StringValue(key.ClientEmail)
+	return diags
+}
+
+// parseGCPServiceAccountKey decodes the service account JSON key, ensuring that it identifies
+// both the project and the service account.
+func parseGCPServiceAccountKey(jsonKey string) (*gcpServiceAccountKey, error) {
+	key := &gcpServiceAccountKey{}
+	if err := json.Unmarshal([]byte(jsonKey), key); err != nil {
+		return nil, err
+	}
+
+	if key.ProjectID == "" {
+		return nil, errors.New(`the key has no "project_id"`)
+	} else if key.ClientEmail == "" {
+		return nil, errors.New(`the key has no "client_email"`)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,149 @@
+package jenkins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccJenkinsCredentialGCPServiceAccount_basic(t *testing.T) {
+	var cred GCPServiceAccountCredentials
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		CheckDestroy:             testAccCheckJenkinsCredentialGCPServiceAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_credential_gcp_service_account foo {
+				  name = "test-gcp-service-account"
+				  json_key = jsonencode({
+				    type = "service_account"
+				    project_id = "test-project"
+				    client_email = "deployer@test-project.iam.gserviceaccount.com"
+				    private_key = "Some fake private key"
+				  })
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_credential_gcp_service_account.foo", "id", "/test-gcp-service-account"),
+					resource.TestCheckResourceAttr("jenkins_credential_gcp_service_account.foo", "project_id", "test-project"),
+					resource.TestCheckResourceAttr("jenkins_credential_gcp_service_account.foo", "client_email", "deployer@test-project.iam.gserviceaccount.com"),
+					testAccCheckJenkinsCredentialGCPServiceAccountExists("jenkins_credential_gcp_service_account.foo", &cred),
+				),
+			},
+			{
+				// Update by rotating the key into another project
+				Config: `
+				resource jenkins_credential_gcp_service_account foo {
+				  name = "test-gcp-service-account"
+				  description = "new-description"
+				  json_key = jsonencode({
+				    type = "service_account"
+				    project_id = "other-project"
+				    client_email = "deployer@other-project.iam.gserviceaccount.com"
+				    private_key = "Some other fake private key"
+				  })
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJenkinsCredentialGCPServiceAccountExists("jenkins_credential_gcp_service_account.foo", &cred),
+					resource.TestCheckResourceAttr("jenkins_credential_gcp_service_account.foo", "description", "new-description"),
+					resource.TestCheckResourceAttr("jenkins_credential_gcp_service_account.foo", "project_id", "other-project"),
+					resource.TestCheckResourceAttr("jenkins_credential_gcp_service_account.foo", "client_email", "deployer@other-project.iam.gserviceaccount.com"),
+				),
+			},
+		},
+	})
+}
+
+func Test_parseGCPServiceAccountKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		jsonKey string
+		want    *gcpServiceAccountKey
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			jsonKey: `{"type":"service_account","project_id":"example","client_email":"deployer@example.iam.gserviceaccount.com","private_key":"key"}`,
+			want:    &gcpServiceAccountKey{ProjectID: "example", ClientEmail: "deployer@example.iam.gserviceaccount.com"},
+		},
+		{
+			name:    "not json",
+			jsonKey: "ewogICJ0eXBlIjogInNlcnZpY2VfYWNjb3VudCIKfQ==",
+			wantErr: true,
+		},
+		{
+			name:    "missing project",
+			jsonKey: `{"client_email":"deployer@example.iam.gserviceaccount.com"}`,
+			wantErr: true,
+		},
+		{
+			name:    "missing email",
+			jsonKey: `{"project_id":"example"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseGCPServiceAccountKey(tt.jsonKey)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseGCPServiceAccountKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseGCPServiceAccountKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func testAccCheckJenkinsCredentialGCPServiceAccountExists(resourceName string, cred *GCPServiceAccountCredentials) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ctx := context.Background()
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return errors.New(resourceName + " not found")
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+
+		manager := testAccClient.Credentials()
+		manager.Folder = formatFolderName(rs.Primary.Attributes["folder"])
+		err := manager.GetSingle(ctx, rs.Primary.Attributes["domain"], rs.Primary.Attributes["name"], cred)
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve credentials for %s - %s: %w", rs.Primary.Attributes["folder"], rs.Primary.Attributes["name"], err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckJenkinsCredentialGCPServiceAccountDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jenkins_credential_gcp_service_account" {
+			continue
+		} else if _, ok := rs.Primary.Meta["name"]; !ok {
+			continue
+		}
+
+		cred := GCPServiceAccountCredentials{}
+		manager := testAccClient.Credentials()
+		manager.Folder = formatFolderName(rs.Primary.Meta["folder"].(string))
+		err := manager.GetSingle(ctx, rs.Primary.Meta["domain"].(string), rs.Primary.Meta["name"].(string), &cred)
+		if err == nil {
+			return fmt.Errorf("Credentials still exists: %s - %s", rs.Primary.Attributes["folder"], rs.Primary.Attributes["name"])
+		}
+	}
+
+	return nil
+}
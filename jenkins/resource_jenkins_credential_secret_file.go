@@ -12,14 +12,18 @@ import (
 )
 
 type credentialSecretFileResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Folder      types.String `tfsdk:"folder"`
-	Description types.String `tfsdk:"description"`
-	Domain      types.String `tfsdk:"domain"`
-	Scope       types.String `tfsdk:"scope"`
-	Filename    types.String `tfsdk:"filename"`
-	SecretBytes types.String `tfsdk:"secretbytes"`
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Folder               types.String `tfsdk:"folder"`
+	Description          types.String `tfsdk:"description"`
+	Domain               types.String `tfsdk:"domain"`
+	Scope                types.String `tfsdk:"scope"`
+	Filename             types.String `tfsdk:"filename"`
+	SecretBytes          types.String `tfsdk:"secretbytes"`
+	SecretBytesWO        types.String `tfsdk:"secretbytes_wo"`
+	SecretBytesWOVersion types.Int64  `tfsdk:"secretbytes_wo_version"`
+	DetectSecretDrift    types.Bool   `tfsdk:"detect_secret_drift"`
+	SecretFingerprint    types.String `tfsdk:"secret_fingerprint"`
 }
 
 type credentialSecretFileResource struct {
@@ -44,8 +48,10 @@ func (r *credentialSecretFileResource) Metadata(_ context.Context, req resource.
 func (r *credentialSecretFileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `
-Manages a secret file credential within Jenkins. This secret file may then be referenced within jobs that are created.`,
-		Attributes: r.schemaCredential(map[string]schema.Attribute{
+Manages a secret file credential within Jenkins. This secret file may then be referenced within jobs that are created.
+
+` + secretsWarning("secretbytes"),
+		Attributes: r.schemaCredential(r.schemaSecrets(map[string]schema.Attribute{
 			"filename": schema.StringAttribute{
 				MarkdownDescription: "The secret file filename on jenkins server side.",
 				Required:            true,
@@ -55,7 +61,7 @@ Manages a secret file credential within Jenkins. This secret file may then be re
 				Required:            true,
 				Sensitive:           true,
 			},
-		}),
+		}, "secretbytes")),
 	}
 }
 
@@ -86,12 +92,18 @@ func (r *credentialSecretFileResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	secretBytes, diags := writeOnlySecret(ctx, req.Config, data.SecretBytes, "secretbytes")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	cred := jenkins.FileCredentials{
 		ID:          data.Name.ValueString(),
 		Scope:       data.Scope.ValueString(),
 		Description: data.Description.ValueString(),
 		Filename:    data.Filename.ValueString(),
-		SecretBytes: data.SecretBytes.ValueString(),
+		SecretBytes: secretBytes.ValueString(),
 	}
 
 	err := cm.Add(ctx, data.Domain.ValueString(), cred)
@@ -112,6 +124,7 @@ func (r *credentialSecretFileResource) Create(ctx context.Context, req resource.
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.recordSecretFingerprint(ctx, &resp.State)...)
 }
 
 // Read is called when the provider must read resource values in order
@@ -158,6 +171,7 @@ func (r *credentialSecretFileResource) Read(ctx context.Context, req resource.Re
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.detectSecretDrift(ctx, &resp.State, "secretbytes")...)
 }
 
 // Update is called to update the state of the resource. Config, planned
@@ -172,9 +186,27 @@ func (r *credentialSecretFileResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	// Relocate the credentials first if their folder or domain has changed
+	if err := r.moveCredential(ctx, req); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to move the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
 	cm := r.client.Credentials()
 	cm.Folder = formatFolderName(data.Folder.ValueString())
 
+	secretBytes, diags := writeOnlySecret(ctx, req.Config, data.SecretBytes, "secretbytes")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	cred := jenkins.FileCredentials{
 		ID:          data.Name.ValueString(),
 		Scope:       data.Scope.ValueString(),
@@ -183,8 +215,8 @@ func (r *credentialSecretFileResource) Update(ctx context.Context, req resource.
 	}
 
 	// Only enforce the password if it is non-empty
-	if data.SecretBytes.ValueString() != "" {
-		cred.SecretBytes = data.SecretBytes.ValueString()
+	if secretBytes.ValueString() != "" {
+		cred.SecretBytes = secretBytes.ValueString()
 	}
 
 	err := cm.Update(ctx, data.Domain.ValueString(), data.Name.ValueString(), &cred)
@@ -199,8 +231,11 @@ func (r *credentialSecretFileResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), data.Name.ValueString()))
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.recordSecretFingerprint(ctx, &resp.State)...)
 }
 
 // Delete is called when the provider must delete the resource. Config
@@ -12,13 +12,17 @@ import (
 )
 
 type credentialSecretTextResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Folder      types.String `tfsdk:"folder"`
-	Description types.String `tfsdk:"description"`
-	Domain      types.String `tfsdk:"domain"`
-	Scope       types.String `tfsdk:"scope"`
-	Secret      types.String `tfsdk:"secret"`
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Folder            types.String `tfsdk:"folder"`
+	Description       types.String `tfsdk:"description"`
+	Domain            types.String `tfsdk:"domain"`
+	Scope             types.String `tfsdk:"scope"`
+	Secret            types.String `tfsdk:"secret"`
+	SecretWO          types.String `tfsdk:"secret_wo"`
+	SecretWOVersion   types.Int64  `tfsdk:"secret_wo_version"`
+	DetectSecretDrift types.Bool   `tfsdk:"detect_secret_drift"`
+	SecretFingerprint types.String `tfsdk:"secret_fingerprint"`
 }
 
 type credentialSecretTextResource struct {
@@ -43,14 +47,18 @@ func (r *credentialSecretTextResource) Metadata(_ context.Context, req resource.
 func (r *credentialSecretTextResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `
-Manages a secret text credential within Jenkins. This secret text may then be referenced within jobs that are created.`,
-		Attributes: r.schemaCredential(map[string]schema.Attribute{
+Manages a secret text credential within Jenkins. This secret text may then be referenced within jobs that are created.
+
+Kubernetes and OpenShift service account tokens are stored as secret text as well, which the [Kubernetes Plugin](https://plugins.jenkins.io/kubernetes/) and the [Kubernetes CLI Plugin](https://plugins.jenkins.io/kubernetes-cli/) accept as the credentials of a cluster. The server URL and CA certificate of the cluster are configured where the credentials are used, such as the Kubernetes cloud or the ` + "`withKubeConfig`" + ` step.
+
+` + secretsWarning("secret"),
+		Attributes: r.schemaCredential(r.schemaSecrets(map[string]schema.Attribute{
 			"secret": schema.StringAttribute{
 				MarkdownDescription: "The secret text to be associated with the credentials.",
 				Required:            true,
 				Sensitive:           true,
 			},
-		}),
+		}, "secret")),
 	}
 }
 
@@ -81,11 +89,17 @@ func (r *credentialSecretTextResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	secret, diags := writeOnlySecret(ctx, req.Config, data.Secret, "secret")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	cred := jenkins.StringCredentials{
 		ID:          data.Name.ValueString(),
 		Scope:       data.Scope.ValueString(),
 		Description: data.Description.ValueString(),
-		Secret:      data.Secret.ValueString(),
+		Secret:      secret.ValueString(),
 	}
 
 	err := cm.Add(ctx, data.Domain.ValueString(), cred)
@@ -106,6 +120,7 @@ func (r *credentialSecretTextResource) Create(ctx context.Context, req resource.
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.recordSecretFingerprint(ctx, &resp.State)...)
 }
 
 // Read is called when the provider must read resource values in order
@@ -151,6 +166,7 @@ func (r *credentialSecretTextResource) Read(ctx context.Context, req resource.Re
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.detectSecretDrift(ctx, &resp.State, "secret")...)
 }
 
 // Update is called to update the state of the resource. Config, planned
@@ -165,9 +181,27 @@ func (r *credentialSecretTextResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	// Relocate the credentials first if their folder or domain has changed
+	if err := r.moveCredential(ctx, req); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to move the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
 	cm := r.client.Credentials()
 	cm.Folder = formatFolderName(data.Folder.ValueString())
 
+	secret, diags := writeOnlySecret(ctx, req.Config, data.Secret, "secret")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	cred := jenkins.StringCredentials{
 		ID:          data.Name.ValueString(),
 		Scope:       data.Scope.ValueString(),
@@ -175,8 +209,8 @@ func (r *credentialSecretTextResource) Update(ctx context.Context, req resource.
 	}
 
 	// Only enforce the password if it is non-empty
-	if data.Secret.ValueString() != "" {
-		cred.Secret = data.Secret.ValueString()
+	if secret.ValueString() != "" {
+		cred.Secret = secret.ValueString()
 	}
 
 	err := cm.Update(ctx, data.Domain.ValueString(), data.Name.ValueString(), &cred)
@@ -191,8 +225,11 @@ func (r *credentialSecretTextResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), data.Name.ValueString()))
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.recordSecretFingerprint(ctx, &resp.State)...)
 }
 
 // Delete is called when the provider must delete the resource. Config
@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	jenkins "github.com/bndr/gojenkins"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccJenkinsCredentialSecretText_basic(t *testing.T) {
@@ -48,6 +51,43 @@ func TestAccJenkinsCredentialSecretText_basic(t *testing.T) {
 	})
 }
 
+func TestAccJenkinsCredentialSecretText_writeOnly(t *testing.T) {
+	var cred jenkins.StringCredentials
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckJenkinsCredentialSecretTextDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_credential_secret_text foo {
+				  name = "test-secret-text"
+				  secret_wo = "very-secret"
+				  secret_wo_version = 1
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJenkinsCredentialSecretTextExists("jenkins_credential_secret_text.foo", &cred),
+					resource.TestCheckNoResourceAttr("jenkins_credential_secret_text.foo", "secret"),
+					resource.TestCheckNoResourceAttr("jenkins_credential_secret_text.foo", "secret_wo"),
+				),
+			},
+			{
+				Config: `
+				resource jenkins_credential_secret_text foo {
+				  name = "test-secret-text"
+				  secret = "very-secret"
+				  secret_wo = "very-secret"
+				}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
 func TestAccJenkinsCredentialSecretText_folder(t *testing.T) {
 	var cred jenkins.StringCredentials
 	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
@@ -120,6 +160,78 @@ func TestAccJenkinsCredentialSecretText_folder(t *testing.T) {
 	})
 }
 
+func TestAccJenkinsCredentialSecretText_move(t *testing.T) {
+	var cred jenkins.StringCredentials
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	config := func(folder, domain string) string {
+		return fmt.Sprintf(`
+		resource jenkins_folder foo {
+		  name = "tf-acc-test-%s-foo"
+		}
+
+		resource jenkins_folder bar {
+		  name = "tf-acc-test-%s-bar"
+		}
+
+		resource jenkins_credential_domain bar {
+		  name   = "github.com"
+		  folder = jenkins_folder.bar.id
+		}
+
+		resource jenkins_credential_secret_text foo {
+		  name   = "test-secret-text-%s"
+		  folder = %s
+		  domain = %s
+		  secret = "very-secret"
+		}`, randString, randString, randString, folder, domain)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckJenkinsCredentialSecretTextDestroy,
+			testAccCheckJenkinsCredentialDomainDestroy,
+			testAccCheckJenkinsFolderDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config("jenkins_folder.foo.id", `"_"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_credential_secret_text.foo", "id", "/job/tf-acc-test-"+randString+"-foo/test-secret-text-"+randString),
+					testAccCheckJenkinsCredentialSecretTextExists("jenkins_credential_secret_text.foo", &cred),
+				),
+			},
+			{
+				// Move into the other folder's domain without replacing the credentials
+				Config: config("jenkins_folder.bar.id", "jenkins_credential_domain.bar.name"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("jenkins_credential_secret_text.foo", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_credential_secret_text.foo", "id", "/job/tf-acc-test-"+randString+"-bar/test-secret-text-"+randString),
+					testAccCheckJenkinsCredentialSecretTextExists("jenkins_credential_secret_text.foo", &cred),
+				),
+			},
+			{
+				// Jenkins cannot move credentials out of a folder into the global store, so they are recreated
+				Config: config("null", `"_"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("jenkins_credential_secret_text.foo", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_credential_secret_text.foo", "id", "/test-secret-text-"+randString),
+					testAccCheckJenkinsCredentialSecretTextExists("jenkins_credential_secret_text.foo", &cred),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckJenkinsCredentialSecretTextExists(resourceName string, cred *jenkins.StringCredentials) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		ctx := context.Background()
@@ -12,15 +12,21 @@ import (
 )
 
 type credentialSSHResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Folder      types.String `tfsdk:"folder"`
-	Description types.String `tfsdk:"description"`
-	Domain      types.String `tfsdk:"domain"`
-	Scope       types.String `tfsdk:"scope"`
-	Username    types.String `tfsdk:"username"`
-	PrivateKey  types.String `tfsdk:"privatekey"`
-	Passphrase  types.String `tfsdk:"passphrase"`
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Folder              types.String `tfsdk:"folder"`
+	Description         types.String `tfsdk:"description"`
+	Domain              types.String `tfsdk:"domain"`
+	Scope               types.String `tfsdk:"scope"`
+	Username            types.String `tfsdk:"username"`
+	PrivateKey          types.String `tfsdk:"privatekey"`
+	PrivateKeyWO        types.String `tfsdk:"privatekey_wo"`
+	PrivateKeyWOVersion types.Int64  `tfsdk:"privatekey_wo_version"`
+	Passphrase          types.String `tfsdk:"passphrase"`
+	PassphraseWO        types.String `tfsdk:"passphrase_wo"`
+	PassphraseWOVersion types.Int64  `tfsdk:"passphrase_wo_version"`
+	DetectSecretDrift   types.Bool   `tfsdk:"detect_secret_drift"`
+	SecretFingerprint   types.String `tfsdk:"secret_fingerprint"`
 }
 
 type credentialSSHResource struct {
@@ -47,8 +53,8 @@ func (r *credentialSSHResource) Schema(_ context.Context, _ resource.SchemaReque
 		MarkdownDescription: `
 Manages a SSH credential within Jenkins. This SSH credential may then be referenced within jobs that are created.
 
-~> The "passphrase" and "privatekey" properties may leave plain-text values in your state file. Ensure that your state file is properly secured and encrypted at rest.`,
-		Attributes: r.schemaCredential(map[string]schema.Attribute{
+` + secretsWarning("passphrase", "privatekey"),
+		Attributes: r.schemaCredential(r.schemaSecrets(map[string]schema.Attribute{
 			"username": schema.StringAttribute{
 				MarkdownDescription: "Username",
 				Required:            true,
@@ -63,7 +69,7 @@ Manages a SSH credential within Jenkins. This SSH credential may then be referen
 				Optional:            true,
 				Sensitive:           true,
 			},
-		}),
+		}, "privatekey", "passphrase")),
 	}
 }
 
@@ -94,6 +100,14 @@ func (r *credentialSSHResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	privateKey, diags := writeOnlySecret(ctx, req.Config, data.PrivateKey, "privatekey")
+	resp.Diagnostics.Append(diags...)
+	passphrase, diags := writeOnlySecret(ctx, req.Config, data.Passphrase, "passphrase")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	cred := jenkins.SSHCredentials{
 		ID:          data.Name.ValueString(),
 		Scope:       data.Scope.ValueString(),
@@ -101,13 +115,12 @@ func (r *credentialSSHResource) Create(ctx context.Context, req resource.CreateR
 		Username:    data.Username.ValueString(),
 		PrivateKeySource: &jenkins.PrivateKey{
 			Class: jenkins.KeySourceDirectEntryType,
-			Value: data.PrivateKey.ValueString(),
+			Value: privateKey.ValueString(),
 		},
 	}
 
-	passphrase := data.Passphrase.ValueString()
-	if len(passphrase) > 0 {
-		cred.Passphrase = passphrase
+	if len(passphrase.ValueString()) > 0 {
+		cred.Passphrase = passphrase.ValueString()
 	}
 
 	err := cm.Add(ctx, data.Domain.ValueString(), cred)
@@ -128,6 +141,7 @@ func (r *credentialSSHResource) Create(ctx context.Context, req resource.CreateR
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.recordSecretFingerprint(ctx, &resp.State)...)
 }
 
 // Read is called when the provider must read resource values in order
@@ -174,6 +188,7 @@ func (r *credentialSSHResource) Read(ctx context.Context, req resource.ReadReque
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.detectSecretDrift(ctx, &resp.State, "privatekey", "passphrase")...)
 }
 
 // Update is called to update the state of the resource. Config, planned
@@ -188,9 +203,29 @@ func (r *credentialSSHResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	// Relocate the credentials first if their folder or domain has changed
+	if err := r.moveCredential(ctx, req); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to move the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
 	cm := r.client.Credentials()
 	cm.Folder = formatFolderName(data.Folder.ValueString())
 
+	privateKey, diags := writeOnlySecret(ctx, req.Config, data.PrivateKey, "privatekey")
+	resp.Diagnostics.Append(diags...)
+	passphrase, diags := writeOnlySecret(ctx, req.Config, data.Passphrase, "passphrase")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	cred := jenkins.SSHCredentials{
 		ID:          data.Name.ValueString(),
 		Scope:       data.Scope.ValueString(),
@@ -198,13 +233,13 @@ func (r *credentialSSHResource) Update(ctx context.Context, req resource.UpdateR
 		Username:    data.Username.ValueString(),
 		PrivateKeySource: &jenkins.PrivateKey{
 			Class: jenkins.KeySourceDirectEntryType,
-			Value: data.PrivateKey.ValueString(),
+			Value: privateKey.ValueString(),
 		},
 	}
 
 	// Only enforce the secrets if non-empty
-	if data.Passphrase.ValueString() != "" {
-		cred.Passphrase = data.Passphrase.ValueString()
+	if passphrase.ValueString() != "" {
+		cred.Passphrase = passphrase.ValueString()
 	}
 
 	err := cm.Update(ctx, data.Domain.ValueString(), data.Name.ValueString(), &cred)
@@ -219,8 +254,11 @@ func (r *credentialSSHResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), data.Name.ValueString()))
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.recordSecretFingerprint(ctx, &resp.State)...)
 }
 
 // Delete is called when the provider must delete the resource. Config
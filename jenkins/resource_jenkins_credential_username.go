@@ -12,14 +12,18 @@ import (
 )
 
 type credentialUsernameResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Folder      types.String `tfsdk:"folder"`
-	Description types.String `tfsdk:"description"`
-	Domain      types.String `tfsdk:"domain"`
-	Scope       types.String `tfsdk:"scope"`
-	Username    types.String `tfsdk:"username"`
-	Password    types.String `tfsdk:"password"`
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Folder            types.String `tfsdk:"folder"`
+	Description       types.String `tfsdk:"description"`
+	Domain            types.String `tfsdk:"domain"`
+	Scope             types.String `tfsdk:"scope"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	DetectSecretDrift types.Bool   `tfsdk:"detect_secret_drift"`
+	SecretFingerprint types.String `tfsdk:"secret_fingerprint"`
 }
 
 type credentialUsernameResource struct {
@@ -46,18 +50,18 @@ func (r *credentialUsernameResource) Schema(_ context.Context, _ resource.Schema
 		MarkdownDescription: `
 Manages a username credential within Jenkins. This username may then be referenced within jobs that are created.
 
-~> The "password" property may leave plain-text passwords in your state file. If using the property to manage the password in Terraform, ensure that your state file is properly secured and encrypted at rest.`,
-		Attributes: r.schemaCredential(map[string]schema.Attribute{
+` + secretsWarning("password"),
+		Attributes: r.schemaCredential(r.schemaSecrets(map[string]schema.Attribute{
 			"username": schema.StringAttribute{
 				MarkdownDescription: "The username to be associated with the credentials.",
 				Required:            true,
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "The password to be associated with the credentials. If empty then the password property will become unmanaged and expected to be set manually within Jenkins. If set then the password will be updated only upon changes -- if the password is set manually within Jenkins then it will not reconcile this drift until the next time the password property is changed.",
+				MarkdownDescription: "The password to be associated with the credentials. If empty then the password property will become unmanaged and expected to be set manually within Jenkins. If set then the password will be updated only upon changes -- if the password is set manually within Jenkins then it will not reconcile this drift until the next time the password property is changed, unless `detect_secret_drift` is enabled.",
 				Optional:            true,
 				Sensitive:           true,
 			},
-		}),
+		}, "password")),
 	}
 }
 
@@ -88,12 +92,18 @@ func (r *credentialUsernameResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	password, diags := writeOnlySecret(ctx, req.Config, data.Password, "password")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	cred := jenkins.UsernameCredentials{
 		ID:          data.Name.ValueString(),
 		Scope:       data.Scope.ValueString(),
 		Description: data.Description.ValueString(),
 		Username:    data.Username.ValueString(),
-		Password:    data.Password.ValueString(),
+		Password:    password.ValueString(),
 	}
 
 	err := cm.Add(ctx, data.Domain.ValueString(), cred)
@@ -114,6 +124,7 @@ func (r *credentialUsernameResource) Create(ctx context.Context, req resource.Cr
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.recordSecretFingerprint(ctx, &resp.State)...)
 }
 
 // Read is called when the provider must read resource values in order
@@ -159,6 +170,7 @@ func (r *credentialUsernameResource) Read(ctx context.Context, req resource.Read
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.detectSecretDrift(ctx, &resp.State, "password")...)
 }
 
 // Update is called to update the state of the resource. Config, planned
@@ -173,9 +185,27 @@ func (r *credentialUsernameResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	// Relocate the credentials first if their folder or domain has changed
+	if err := r.moveCredential(ctx, req); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to move the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
 	cm := r.client.Credentials()
 	cm.Folder = formatFolderName(data.Folder.ValueString())
 
+	password, diags := writeOnlySecret(ctx, req.Config, data.Password, "password")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	cred := jenkins.UsernameCredentials{
 		ID:          data.Name.ValueString(),
 		Scope:       data.Scope.ValueString(),
@@ -184,8 +214,8 @@ func (r *credentialUsernameResource) Update(ctx context.Context, req resource.Up
 	}
 
 	// Only enforce the password if it is non-empty
-	if data.Password.ValueString() != "" {
-		cred.Password = data.Password.ValueString()
+	if password.ValueString() != "" {
+		cred.Password = password.ValueString()
 	}
 
 	err := cm.Update(ctx, data.Domain.ValueString(), data.Name.ValueString(), &cred)
@@ -200,8 +230,11 @@ func (r *credentialUsernameResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), data.Name.ValueString()))
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.recordSecretFingerprint(ctx, &resp.State)...)
 }
 
 // Delete is called when the provider must delete the resource. Config
@@ -9,7 +9,9 @@ import (
 	jenkins "github.com/bndr/gojenkins"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccJenkinsCredentialUsername_basic(t *testing.T) {
@@ -46,6 +48,132 @@ func TestAccJenkinsCredentialUsername_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("jenkins_credential_username.foo", "description", "new-description"),
 				),
 			},
+			{
+				ResourceName:            "jenkins_credential_username.foo",
+				ImportState:             true,
+				ImportStateId:           "_/test-username",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "secret_fingerprint"},
+			},
+		},
+	})
+}
+
+func TestAccJenkinsCredentialUsername_writeOnly(t *testing.T) {
+	var cred jenkins.UsernameCredentials
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckJenkinsCredentialUsernameDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_credential_username foo {
+				  name = "test-username"
+				  username = "foo"
+				  password_wo = "bar"
+				  password_wo_version = 1
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJenkinsCredentialUsernameExists("jenkins_credential_username.foo", &cred),
+					resource.TestCheckNoResourceAttr("jenkins_credential_username.foo", "password"),
+					resource.TestCheckNoResourceAttr("jenkins_credential_username.foo", "password_wo"),
+					resource.TestCheckResourceAttr("jenkins_credential_username.foo", "password_wo_version", "1"),
+				),
+			},
+			{
+				// Update by rotating the password
+				Config: `
+				resource jenkins_credential_username foo {
+				  name = "test-username"
+				  username = "foo"
+				  password_wo = "baz"
+				  password_wo_version = 2
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJenkinsCredentialUsernameExists("jenkins_credential_username.foo", &cred),
+					resource.TestCheckNoResourceAttr("jenkins_credential_username.foo", "password_wo"),
+					resource.TestCheckResourceAttr("jenkins_credential_username.foo", "password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJenkinsCredentialUsername_detectSecretDrift(t *testing.T) {
+	var cred jenkins.UsernameCredentials
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	config := fmt.Sprintf(`
+	resource jenkins_credential_username foo {
+	  name = "tf-acc-test-%s"
+	  username = "foo"
+	  password = "bar"
+	  detect_secret_drift = true
+	}
+
+	resource jenkins_job foo {
+	  name     = "tf-acc-test-%s"
+	  template = <<EOT
+<flow-definition>
+  <definition class="org.jenkinsci.plugins.workflow.cps.CpsFlowDefinition">
+    <script>node { withCredentials([usernamePassword(credentialsId: '${jenkins_credential_username.foo.name}', usernameVariable: 'USERNAME', passwordVariable: 'PASSWORD')]) { echo 'Bound' } }</script>
+    <sandbox>true</sandbox>
+  </definition>
+</flow-definition>
+EOT
+	}`, randString, randString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckJenkinsCredentialUsernameDestroy,
+			testAccCheckJenkinsJobDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJenkinsCredentialUsernameExists("jenkins_credential_username.foo", &cred),
+					// Jenkins only fingerprints credentials once they are used
+					resource.TestCheckNoResourceAttr("jenkins_credential_username.foo", "secret_fingerprint"),
+				),
+			},
+			{
+				// Using the credentials records their fingerprint, which is then adopted
+				PreConfig: func() { testAccBuildJob(t, "tf-acc-test-"+randString) },
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("jenkins_credential_username.foo", "secret_fingerprint"),
+				),
+			},
+			{
+				// Replace the password outside of Terraform, and use the changed credentials
+				PreConfig: func() {
+					manager := testAccClient.Credentials()
+					cred.Password = "changed-in-the-ui"
+					if err := manager.Update(context.Background(), "_", cred.ID, &cred); err != nil {
+						t.Fatalf("Unable to update credentials: %s", err)
+					}
+					testAccBuildJob(t, "tf-acc-test-"+randString)
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Applying enforces the password again
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("jenkins_credential_username.foo", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
 		},
 	})
 }
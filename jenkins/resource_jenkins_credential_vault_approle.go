@@ -25,16 +25,20 @@ type VaultAppRoleCredentials struct {
 }
 
 type credentialVaultAppRoleResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Folder      types.String `tfsdk:"folder"`
-	Description types.String `tfsdk:"description"`
-	Domain      types.String `tfsdk:"domain"`
-	Scope       types.String `tfsdk:"scope"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Path        types.String `tfsdk:"path"`
-	RoleID      types.String `tfsdk:"role_id"`
-	SecretID    types.String `tfsdk:"secret_id"`
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Folder            types.String `tfsdk:"folder"`
+	Description       types.String `tfsdk:"description"`
+	Domain            types.String `tfsdk:"domain"`
+	Scope             types.String `tfsdk:"scope"`
+	Namespace         types.String `tfsdk:"namespace"`
+	Path              types.String `tfsdk:"path"`
+	RoleID            types.String `tfsdk:"role_id"`
+	SecretID          types.String `tfsdk:"secret_id"`
+	SecretIDWO        types.String `tfsdk:"secret_id_wo"`
+	SecretIDWOVersion types.Int64  `tfsdk:"secret_id_wo_version"`
+	DetectSecretDrift types.Bool   `tfsdk:"detect_secret_drift"`
+	SecretFingerprint types.String `tfsdk:"secret_fingerprint"`
 }
 
 type credentialVaultAppRoleResource struct {
@@ -61,10 +65,10 @@ func (r *credentialVaultAppRoleResource) Schema(_ context.Context, _ resource.Sc
 		MarkdownDescription: `
 Manages a Vault AppRole credential within Jenkins. This credential may then be referenced within jobs that are created.
 
-~> The "secret_id" property may leave plain-text secret id in your state file. If using the property to manage the secret id in Terraform, ensure that your state file is properly secured and encrypted at rest.
+` + secretsWarning("secret_id") + `
 
 ~> The Jenkins installation that uses this resource is expected to have the [Hashicorp Vault Plugin](https://plugins.jenkins.io/hashicorp-vault-plugin/) installed in their system.`,
-		Attributes: r.schemaCredential(map[string]schema.Attribute{
+		Attributes: r.schemaCredential(r.schemaSecrets(map[string]schema.Attribute{
 			"namespace": schema.StringAttribute{
 				MarkdownDescription: "The Vault namespace of the approle credential.",
 				Optional:            true,
@@ -82,11 +86,11 @@ Manages a Vault AppRole credential within Jenkins. This credential may then be r
 				Required:            true,
 			},
 			"secret_id": schema.StringAttribute{
-				MarkdownDescription: "The secret_id to be associated with the credentials. If empty then the secret_id property will become unmanaged and expected to be set manually within Jenkins. If set then the secret_id will be updated only upon changes -- if the secret_id is set manually within Jenkins then it will not reconcile this drift until the next time the secret_id property is changed.",
+				MarkdownDescription: "The secret_id to be associated with the credentials. If empty then the secret_id property will become unmanaged and expected to be set manually within Jenkins. If set then the secret_id will be updated only upon changes -- if the secret_id is set manually within Jenkins then it will not reconcile this drift until the next time the secret_id property is changed, unless `detect_secret_drift` is enabled.",
 				Optional:            true,
 				Sensitive:           true,
 			},
-		}),
+		}, "secret_id")),
 	}
 }
 
@@ -117,6 +121,12 @@ func (r *credentialVaultAppRoleResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	secretID, diags := writeOnlySecret(ctx, req.Config, data.SecretID, "secret_id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	cred := VaultAppRoleCredentials{
 		ID:          data.Name.ValueString(),
 		Scope:       data.Scope.ValueString(),
@@ -124,7 +134,7 @@ func (r *credentialVaultAppRoleResource) Create(ctx context.Context, req resourc
 		Namespace:   data.Namespace.ValueString(),
 		Path:        data.Path.ValueString(),
 		RoleID:      data.RoleID.ValueString(),
-		SecretID:    data.SecretID.ValueString(),
+		SecretID:    secretID.ValueString(),
 	}
 
 	err := cm.Add(ctx, data.Domain.ValueString(), cred)
@@ -145,6 +155,7 @@ func (r *credentialVaultAppRoleResource) Create(ctx context.Context, req resourc
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.recordSecretFingerprint(ctx, &resp.State)...)
 }
 
 // Read is called when the provider must read resource values in order
@@ -192,6 +203,7 @@ func (r *credentialVaultAppRoleResource) Read(ctx context.Context, req resource.
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.detectSecretDrift(ctx, &resp.State, "secret_id")...)
 }
 
 // Update is called to update the state of the resource. Config, planned
@@ -206,9 +218,27 @@ func (r *credentialVaultAppRoleResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	// Relocate the credentials first if their folder or domain has changed
+	if err := r.moveCredential(ctx, req); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to move the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
 	cm := r.client.Credentials()
 	cm.Folder = formatFolderName(data.Folder.ValueString())
 
+	secretID, diags := writeOnlySecret(ctx, req.Config, data.SecretID, "secret_id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	cred := VaultAppRoleCredentials{
 		ID:          data.Name.ValueString(),
 		Scope:       data.Scope.ValueString(),
@@ -219,8 +249,8 @@ func (r *credentialVaultAppRoleResource) Update(ctx context.Context, req resourc
 	}
 
 	// Only enforce the password if it is non-empty
-	if data.SecretID.ValueString() != "" {
-		cred.SecretID = data.SecretID.ValueString()
+	if secretID.ValueString() != "" {
+		cred.SecretID = secretID.ValueString()
 	}
 
 	err := cm.Update(ctx, data.Domain.ValueString(), data.Name.ValueString(), &cred)
@@ -235,8 +265,11 @@ func (r *credentialVaultAppRoleResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	data.ID = types.StringValue(generateCredentialID(data.Folder.ValueString(), data.Name.ValueString()))
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.recordSecretFingerprint(ctx, &resp.State)...)
 }
 
 // Delete is called when the provider must delete the resource. Config
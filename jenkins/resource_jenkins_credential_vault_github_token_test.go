@@ -0,0 +1,98 @@
+package jenkins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccJenkinsCredentialVaultGitHubToken_basic(t *testing.T) {
+	var cred VaultGitHubTokenCredentials
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		CheckDestroy:             testAccCheckJenkinsCredentialVaultGitHubTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_credential_vault_github_token foo {
+				  name = "test-vault-github-token"
+				  access_token = "foo"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_credential_vault_github_token.foo", "id", "/test-vault-github-token"),
+					resource.TestCheckResourceAttr("jenkins_credential_vault_github_token.foo", "path", "github"),
+					testAccCheckJenkinsCredentialVaultGitHubTokenExists("jenkins_credential_vault_github_token.foo", &cred),
+				),
+			},
+			{
+				// Update by adding description and namespace
+				Config: `
+				resource jenkins_credential_vault_github_token foo {
+				  name = "test-vault-github-token"
+				  description = "new-description"
+				  namespace = "my-namespace"
+				  path = "github-enterprise"
+				  access_token = "foo"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJenkinsCredentialVaultGitHubTokenExists("jenkins_credential_vault_github_token.foo", &cred),
+					resource.TestCheckResourceAttr("jenkins_credential_vault_github_token.foo", "description", "new-description"),
+					resource.TestCheckResourceAttr("jenkins_credential_vault_github_token.foo", "namespace", "my-namespace"),
+					resource.TestCheckResourceAttr("jenkins_credential_vault_github_token.foo", "path", "github-enterprise"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJenkinsCredentialVaultGitHubTokenExists(resourceName string, cred *VaultGitHubTokenCredentials) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ctx := context.Background()
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return errors.New(resourceName + " not found")
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+
+		manager := testAccClient.Credentials()
+		manager.Folder = formatFolderName(rs.Primary.Attributes["folder"])
+		err := manager.GetSingle(ctx, rs.Primary.Attributes["domain"], rs.Primary.Attributes["name"], cred)
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve credentials for %s - %s: %w", rs.Primary.Attributes["folder"], rs.Primary.Attributes["name"], err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckJenkinsCredentialVaultGitHubTokenDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jenkins_credential_vault_github_token" {
+			continue
+		} else if _, ok := rs.Primary.Meta["name"]; !ok {
+			continue
+		}
+
+		cred := VaultGitHubTokenCredentials{}
+		manager := testAccClient.Credentials()
+		manager.Folder = formatFolderName(rs.Primary.Meta["folder"].(string))
+		err := manager.GetSingle(ctx, rs.Primary.Meta["domain"].(string), rs.Primary.Meta["name"].(string), &cred)
+		if err == nil {
+			return fmt.Errorf("Credentials still exists: %s - %s", rs.Primary.Attributes["folder"], rs.Primary.Attributes["name"])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package jenkins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccJenkinsCredentialVaultKubernetes_basic(t *testing.T) {
+	var cred VaultKubernetesCredentials
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		CheckDestroy:             testAccCheckJenkinsCredentialVaultKubernetesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_credential_vault_kubernetes foo {
+				  name = "test-vault-kubernetes"
+				  role = "jenkins"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_credential_vault_kubernetes.foo", "id", "/test-vault-kubernetes"),
+					resource.TestCheckResourceAttr("jenkins_credential_vault_kubernetes.foo", "path", "kubernetes"),
+					testAccCheckJenkinsCredentialVaultKubernetesExists("jenkins_credential_vault_kubernetes.foo", &cred),
+				),
+			},
+			{
+				// Update by adding description and namespace
+				Config: `
+				resource jenkins_credential_vault_kubernetes foo {
+				  name = "test-vault-kubernetes"
+				  description = "new-description"
+				  namespace = "my-namespace"
+				  role = "jenkins-agents"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJenkinsCredentialVaultKubernetesExists("jenkins_credential_vault_kubernetes.foo", &cred),
+					resource.TestCheckResourceAttr("jenkins_credential_vault_kubernetes.foo", "description", "new-description"),
+					resource.TestCheckResourceAttr("jenkins_credential_vault_kubernetes.foo", "namespace", "my-namespace"),
+					resource.TestCheckResourceAttr("jenkins_credential_vault_kubernetes.foo", "role", "jenkins-agents"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJenkinsCredentialVaultKubernetesExists(resourceName string, cred *VaultKubernetesCredentials) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ctx := context.Background()
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return errors.New(resourceName + " not found")
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+
+		manager := testAccClient.Credentials()
+		manager.Folder = formatFolderName(rs.Primary.Attributes["folder"])
+		err := manager.GetSingle(ctx, rs.Primary.Attributes["domain"], rs.Primary.Attributes["name"], cred)
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve credentials for %s - %s: %w", rs.Primary.Attributes["folder"], rs.Primary.Attributes["name"], err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckJenkinsCredentialVaultKubernetesDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jenkins_credential_vault_kubernetes" {
+			continue
+		} else if _, ok := rs.Primary.Meta["name"]; !ok {
+			continue
+		}
+
+		cred := VaultKubernetesCredentials{}
+		manager := testAccClient.Credentials()
+		manager.Folder = formatFolderName(rs.Primary.Meta["folder"].(string))
+		err := manager.GetSingle(ctx, rs.Primary.Meta["domain"].(string), rs.Primary.Meta["name"].(string), &cred)
+		if err == nil {
+			return fmt.Errorf("Credentials still exists: %s - %s", rs.Primary.Attributes["folder"], rs.Primary.Attributes["name"])
+		}
+	}
+
+	return nil
+}
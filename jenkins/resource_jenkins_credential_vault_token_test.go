@@ -0,0 +1,96 @@
+package jenkins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccJenkinsCredentialVaultToken_basic(t *testing.T) {
+	var cred VaultTokenCredentials
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		CheckDestroy:             testAccCheckJenkinsCredentialVaultTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_credential_vault_token foo {
+				  name = "test-vault-token"
+				  token = "foo"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_credential_vault_token.foo", "id", "/test-vault-token"),
+					testAccCheckJenkinsCredentialVaultTokenExists("jenkins_credential_vault_token.foo", &cred),
+				),
+			},
+			{
+				// Update by adding description and namespace
+				Config: `
+				resource jenkins_credential_vault_token foo {
+				  name = "test-vault-token"
+				  description = "new-description"
+				  namespace = "my-namespace"
+				  token = "bar"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJenkinsCredentialVaultTokenExists("jenkins_credential_vault_token.foo", &cred),
+					resource.TestCheckResourceAttr("jenkins_credential_vault_token.foo", "description", "new-description"),
+					resource.TestCheckResourceAttr("jenkins_credential_vault_token.foo", "namespace", "my-namespace"),
+					resource.TestCheckResourceAttr("jenkins_credential_vault_token.foo", "token", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJenkinsCredentialVaultTokenExists(resourceName string, cred *VaultTokenCredentials) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ctx := context.Background()
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return errors.New(resourceName + " not found")
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+
+		manager := testAccClient.Credentials()
+		manager.Folder = formatFolderName(rs.Primary.Attributes["folder"])
+		err := manager.GetSingle(ctx, rs.Primary.Attributes["domain"], rs.Primary.Attributes["name"], cred)
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve credentials for %s - %s: %w", rs.Primary.Attributes["folder"], rs.Primary.Attributes["name"], err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckJenkinsCredentialVaultTokenDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jenkins_credential_vault_token" {
+			continue
+		} else if _, ok := rs.Primary.Meta["name"]; !ok {
+			continue
+		}
+
+		cred := VaultTokenCredentials{}
+		manager := testAccClient.Credentials()
+		manager.Folder = formatFolderName(rs.Primary.Meta["folder"].(string))
+		err := manager.GetSingle(ctx, rs.Primary.Meta["domain"].(string), rs.Primary.Meta["name"].(string), &cred)
+		if err == nil {
+			return fmt.Errorf("Credentials still exists: %s - %s", rs.Primary.Attributes["folder"], rs.Primary.Attributes["name"])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,599 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	credentialsProviderAWSSecretsManager = "aws_secrets_manager"
+	credentialsProviderKubernetes        = "kubernetes"
+	credentialsProviderVault             = "vault"
+)
+
+// credentialsProviders lists the names of the providers that may be configured.
+var credentialsProviders = []string{credentialsProviderAWSSecretsManager, credentialsProviderKubernetes, credentialsProviderVault}
+
+type credentialsProviderConfigResourceModel struct {
+	ID                types.String                                     `tfsdk:"id"`
+	AWSSecretsManager *credentialsProviderConfigAWSSecretsManagerModel `tfsdk:"aws_secrets_manager"`
+	Kubernetes        *credentialsProviderConfigKubernetesModel        `tfsdk:"kubernetes"`
+	Vault             *credentialsProviderConfigVaultModel             `tfsdk:"vault"`
+}
+
+type credentialsProviderConfigAWSSecretsManagerModel struct {
+	Region          types.String                              `tfsdk:"region"`
+	EndpointURL     types.String                              `tfsdk:"endpoint_url"`
+	SigningRegion   types.String                              `tfsdk:"signing_region"`
+	RoleArn         types.String                              `tfsdk:"role_arn"`
+	RoleSessionName types.String                              `tfsdk:"role_session_name"`
+	Cache           types.Bool                                `tfsdk:"cache"`
+	Filters         []credentialsProviderConfigAWSFilterModel `tfsdk:"filters"`
+}
+
+type credentialsProviderConfigAWSFilterModel struct {
+	Key    types.String   `tfsdk:"key"`
+	Values []types.String `tfsdk:"values"`
+}
+
+type credentialsProviderConfigKubernetesModel struct {
+	Namespace     types.String `tfsdk:"namespace"`
+	LabelSelector types.String `tfsdk:"label_selector"`
+}
+
+type credentialsProviderConfigVaultModel struct {
+	URL                 types.String `tfsdk:"url"`
+	CredentialsID       types.String `tfsdk:"credentials_id"`
+	Namespace           types.String `tfsdk:"namespace"`
+	PrefixPath          types.String `tfsdk:"prefix_path"`
+	EngineVersion       types.Int64  `tfsdk:"engine_version"`
+	SkipSslVerification types.Bool   `tfsdk:"skip_ssl_verification"`
+	Timeout             types.Int64  `tfsdk:"timeout"`
+}
+
+type credentialsProviderConfigResource struct {
+	*resourceHelper
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ resource.ResourceWithConfigure = &credentialsProviderConfigResource{}
+var _ resource.ResourceWithImportState = &credentialsProviderConfigResource{}
+
+func newCredentialsProviderConfigResource() resource.Resource {
+	return &credentialsProviderConfigResource{
+		resourceHelper: newResourceHelper(),
+	}
+}
+
+// Metadata should return the full name of the resource.
+func (r *credentialsProviderConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credentials_provider_config"
+}
+
+// Schema should return the schema for this resource.
+func (r *credentialsProviderConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	providers := []path.Expression{
+		path.MatchRoot(credentialsProviderAWSSecretsManager),
+		path.MatchRoot(credentialsProviderKubernetes),
+		path.MatchRoot(credentialsProviderVault),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manages the system configuration of a credential provider within Jenkins, allowing jobs to resolve credentials from an external secret store rather than storing them within Jenkins. Exactly one provider must be configured per resource, and each provider should only be managed by a single resource. When the resource is destroyed the provider is reset to its defaults.
+
+~> The configuration is applied through the [Configuration as Code Plugin](https://plugins.jenkins.io/configuration-as-code/), which must be installed in the system. The credentials used by the provider must have the "Overall/Administer" permission.
+
+~> The Kubernetes Credentials Provider Plugin is configured through system properties of the controller, which are set through the script console. They do not survive a restart of the controller, after which Terraform plans to set them again. Set them in the Java options of the controller as well to have them in effect from startup.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: `The name of the configured provider, one of "aws_secrets_manager", "kubernetes" or "vault".`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			credentialsProviderAWSSecretsManager: schema.SingleNestedAttribute{
+				MarkdownDescription: "Configures the [AWS Secrets Manager Credentials Provider Plugin](https://plugins.jenkins.io/aws-secrets-manager-credentials-provider/).",
+				Optional:            true,
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(providers...),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIf(requiresReplaceIfProviderChanges, "", ""),
+				},
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						MarkdownDescription: "The AWS region of the Secrets Manager. If not set then the region is discovered from the controller's environment.",
+						Optional:            true,
+					},
+					"endpoint_url": schema.StringAttribute{
+						MarkdownDescription: "Override the Secrets Manager endpoint URL, such as for a VPC endpoint.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("signing_region")),
+						},
+					},
+					"signing_region": schema.StringAttribute{
+						MarkdownDescription: "The region used to sign requests to the overridden `endpoint_url`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("endpoint_url")),
+						},
+					},
+					"role_arn": schema.StringAttribute{
+						MarkdownDescription: "The ARN of an IAM role to assume when accessing the Secrets Manager. If not set then the credentials of the controller's environment are used.",
+						Optional:            true,
+					},
+					"role_session_name": schema.StringAttribute{
+						MarkdownDescription: "The session name used when assuming `role_arn`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("role_arn")),
+						},
+					},
+					"cache": schema.BoolAttribute{
+						MarkdownDescription: "Whether the secrets are cached by the controller. Defaults to `true`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+					"filters": schema.ListNestedAttribute{
+						MarkdownDescription: "Filters limiting the secrets that are exposed as credentials. If not set then all secrets are exposed.",
+						Optional:            true,
+						Validators: []validator.List{
+							// Jenkins does not export an empty list of filters, so it could never be read back
+							listvalidator.SizeAtLeast(1),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"key": schema.StringAttribute{
+									MarkdownDescription: `The attribute to filter on, such as "name", "tag-key" or "tag-value".`,
+									Required:            true,
+								},
+								"values": schema.ListAttribute{
+									MarkdownDescription: "The values of the attribute to match.",
+									ElementType:         types.StringType,
+									Required:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+			credentialsProviderKubernetes: schema.SingleNestedAttribute{
+				MarkdownDescription: "Configures the [Kubernetes Credentials Provider Plugin](https://plugins.jenkins.io/kubernetes-credentials-provider/), which exposes Kubernetes secrets as credentials.",
+				Optional:            true,
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(providers...),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIf(requiresReplaceIfProviderChanges, "", ""),
+				},
+				Attributes: map[string]schema.Attribute{
+					"namespace": schema.StringAttribute{
+						MarkdownDescription: "The namespace to read the secrets from, set as the `kubernetes.namespace` system property. If not set then the namespace of the controller is used.",
+						Optional:            true,
+					},
+					"label_selector": schema.StringAttribute{
+						MarkdownDescription: "A label selector limiting the secrets that are exposed as credentials, set as the `com.cloudbees.jenkins.plugins.kubernetes_credentials_provider.KubernetesCredentialProvider.labelSelector` system property. If not set then all secrets labelled with a credentials type are exposed.",
+						Optional:            true,
+					},
+				},
+			},
+			credentialsProviderVault: schema.SingleNestedAttribute{
+				MarkdownDescription: "Configures the [HashiCorp Vault Plugin](https://plugins.jenkins.io/hashicorp-vault-plugin/).",
+				Optional:            true,
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(providers...),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIf(requiresReplaceIfProviderChanges, "", ""),
+				},
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						MarkdownDescription: "The URL of the Vault server.",
+						Required:            true,
+					},
+					"credentials_id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the Vault credentials used to authenticate, such as a `jenkins_credential_vault_approle`.",
+						Optional:            true,
+					},
+					"namespace": schema.StringAttribute{
+						MarkdownDescription: "The Vault Enterprise namespace to authenticate against.",
+						Optional:            true,
+					},
+					"prefix_path": schema.StringAttribute{
+						MarkdownDescription: "A path prefixed to the secret paths requested by jobs.",
+						Optional:            true,
+					},
+					"engine_version": schema.Int64Attribute{
+						MarkdownDescription: "The version of the KV secrets engine. Defaults to `2`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(2),
+					},
+					"skip_ssl_verification": schema.BoolAttribute{
+						MarkdownDescription: "Whether to skip verification of the Vault server's certificate. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"timeout": schema.Int64Attribute{
+						MarkdownDescription: "The timeout in seconds of requests to the Vault server. Defaults to `60`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(60),
+					},
+				},
+			},
+		},
+	}
+}
+
+// requiresReplaceIfProviderChanges replaces the resource when it switches to another provider,
+// so that the previous provider is reset rather than left configured.
+func requiresReplaceIfProviderChanges(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+}
+
+// Create is called when the provider must create a new resource. Config
+// and planned state values should be read from the
+// CreateRequest and new state values set on the CreateResponse.
+func (r *credentialsProviderConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data credentialsProviderConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &data); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while creating the resource. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Convert from the API data model to the Terraform data model
+	// and set any unknown attribute values.
+	data.ID = types.StringValue(data.provider())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called when the provider must read resource values in order
+// to update state. Planned state values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (r *credentialsProviderConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data credentialsProviderConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.ValueString() == credentialsProviderKubernetes {
+		config, err := r.client.GetKubernetesProviderConfiguration(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Refresh Resource",
+				"An unexpected error occurred while parsing the resource read response. "+
+					"Please report this issue to the provider developers.\n\n"+
+					"Error: "+err.Error(),
+			)
+
+			return
+		}
+		if !config.Installed {
+			// Provider is no longer available
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		data.Kubernetes = &credentialsProviderConfigKubernetesModel{
+			Namespace:     optionalStringValue(config.Namespace),
+			LabelSelector: optionalStringValue(config.LabelSelector),
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	config, err := r.client.GetCredentialsProviderConfiguration(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while parsing the resource read response. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	switch data.ID.ValueString() {
+	case credentialsProviderAWSSecretsManager:
+		if config.AWSSecretsManager == nil {
+			// Provider is no longer configured
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		data.AWSSecretsManager = flattenAWSSecretsManagerProviderConfiguration(config.AWSSecretsManager)
+	case credentialsProviderVault:
+		if config.Vault == nil || config.Vault.Configuration == nil || config.Vault.Configuration.VaultURL == "" {
+			// Provider is no longer configured
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		data.Vault = flattenVaultProviderConfiguration(config.Vault.Configuration)
+	default:
+		resp.Diagnostics.AddError(
+			"Unexpected Provider",
+			fmt.Sprintf("Expected the provider to be one of %q. Got: %q", credentialsProviders, data.ID.ValueString()),
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is called to update the state of the resource. Config, planned
+// state, and prior state values should be read from the
+// UpdateRequest and new state values set on the UpdateResponse.
+func (r *credentialsProviderConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data credentialsProviderConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &data); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	data.ID = types.StringValue(data.provider())
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *credentialsProviderConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data credentialsProviderConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.ValueString() == credentialsProviderKubernetes {
+		if err := r.client.ApplyKubernetesProviderConfiguration(ctx, &kubernetesProviderConfiguration{}); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Resource",
+				"An unexpected error occurred while deleting the resource. "+
+					"Please report this issue to the provider developers.\n\n"+
+					"Error: "+err.Error(),
+			)
+		}
+
+		return
+	}
+
+	// Replacing the nested configuration resets any settings that are not given
+	config := &credentialsProviderConfiguration{}
+	switch data.ID.ValueString() {
+	case credentialsProviderAWSSecretsManager:
+		config.AWSSecretsManager = &awsSecretsManagerProviderConfiguration{
+			Client:      &awsSecretsManagerClient{},
+			ListSecrets: &awsSecretsManagerListSecrets{},
+		}
+	case credentialsProviderVault:
+		config.Vault = &vaultProviderConfiguration{
+			Configuration: &vaultConfiguration{},
+		}
+	}
+
+	if err := r.client.ApplyCredentialsProviderConfiguration(ctx, config); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while deleting the resource. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ImportState is called when performing import operations of existing resources.
+func (r *credentialsProviderConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !slices.Contains(credentialsProviders, req.ID) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier to be one of %q. Got: %q", credentialsProviders, req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// apply configures the provider of the model within Jenkins.
+func (r *credentialsProviderConfigResource) apply(ctx context.Context, data *credentialsProviderConfigResourceModel) error {
+	if k := data.Kubernetes; k != nil {
+		return r.client.ApplyKubernetesProviderConfiguration(ctx, &kubernetesProviderConfiguration{
+			Namespace:     k.Namespace.ValueString(),
+			LabelSelector: k.LabelSelector.ValueString(),
+		})
+	}
+
+	return r.client.ApplyCredentialsProviderConfiguration(ctx, data.expand())
+}
+
+// provider returns the name of the provider configured by the model.
+func (m *credentialsProviderConfigResourceModel) provider() string {
+	if m.Kubernetes != nil {
+		return credentialsProviderKubernetes
+	}
+	if m.Vault != nil {
+		return credentialsProviderVault
+	}
+	return credentialsProviderAWSSecretsManager
+}
+
+// expand converts the Terraform data model into the configuration of the provider.
+func (m *credentialsProviderConfigResourceModel) expand() *credentialsProviderConfiguration {
+	ret := &credentialsProviderConfiguration{}
+
+	if aws := m.AWSSecretsManager; aws != nil {
+		cache := aws.Cache.ValueBool()
+		ret.AWSSecretsManager = &awsSecretsManagerProviderConfiguration{
+			Cache:       &cache,
+			Client:      &awsSecretsManagerClient{Region: aws.Region.ValueString()},
+			ListSecrets: &awsSecretsManagerListSecrets{},
+		}
+
+		if !aws.EndpointURL.IsNull() {
+			ret.AWSSecretsManager.Client.EndpointConfiguration = &awsSecretsManagerEndpointConfiguration{
+				ServiceEndpoint: aws.EndpointURL.ValueString(),
+				SigningRegion:   aws.SigningRegion.ValueString(),
+			}
+		}
+		if !aws.RoleArn.IsNull() {
+			ret.AWSSecretsManager.Client.CredentialsProvider = &awsSecretsManagerCredentialsProvider{
+				AssumeRole: &awsSecretsManagerAssumeRole{
+					RoleArn:         aws.RoleArn.ValueString(),
+					RoleSessionName: aws.RoleSessionName.ValueString(),
+				},
+			}
+		}
+		for _, filter := range aws.Filters {
+			f := awsSecretsManagerFilter{Key: filter.Key.ValueString(), Values: []string{}}
+			for _, value := range filter.Values {
+				f.Values = append(f.Values, value.ValueString())
+			}
+			ret.AWSSecretsManager.ListSecrets.Filters = append(ret.AWSSecretsManager.ListSecrets.Filters, f)
+		}
+	}
+
+	if vault := m.Vault; vault != nil {
+		ret.Vault = &vaultProviderConfiguration{
+			Configuration: &vaultConfiguration{
+				VaultURL:            vault.URL.ValueString(),
+				VaultCredentialID:   vault.CredentialsID.ValueString(),
+				VaultNamespace:      vault.Namespace.ValueString(),
+				PrefixPath:          vault.PrefixPath.ValueString(),
+				EngineVersion:       vault.EngineVersion.ValueInt64(),
+				SkipSslVerification: vault.SkipSslVerification.ValueBool(),
+				Timeout:             vault.Timeout.ValueInt64(),
+			},
+		}
+	}
+
+	return ret
+}
+
+// flattenAWSSecretsManagerProviderConfiguration converts the provider configuration into the Terraform data model.
+func flattenAWSSecretsManagerProviderConfiguration(c *awsSecretsManagerProviderConfiguration) *credentialsProviderConfigAWSSecretsManagerModel {
+	ret := &credentialsProviderConfigAWSSecretsManagerModel{
+		Region:          types.StringNull(),
+		EndpointURL:     types.StringNull(),
+		SigningRegion:   types.StringNull(),
+		RoleArn:         types.StringNull(),
+		RoleSessionName: types.StringNull(),
+		Cache:           types.BoolValue(c.Cache == nil || *c.Cache),
+	}
+
+	if c.Client != nil {
+		ret.Region = optionalStringValue(c.Client.Region)
+		if e := c.Client.EndpointConfiguration; e != nil {
+			ret.EndpointURL = optionalStringValue(e.ServiceEndpoint)
+			ret.SigningRegion = optionalStringValue(e.SigningRegion)
+		}
+		if p := c.Client.CredentialsProvider; p != nil && p.AssumeRole != nil {
+			ret.RoleArn = optionalStringValue(p.AssumeRole.RoleArn)
+			ret.RoleSessionName = optionalStringValue(p.AssumeRole.RoleSessionName)
+		}
+	}
+
+	if c.ListSecrets != nil {
+		for _, filter := range c.ListSecrets.Filters {
+			f := credentialsProviderConfigAWSFilterModel{Key: types.StringValue(filter.Key), Values: []types.String{}}
+			for _, value := range filter.Values {
+				f.Values = append(f.Values, types.StringValue(value))
+			}
+			ret.Filters = append(ret.Filters, f)
+		}
+	}
+
+	return ret
+}
+
+// flattenVaultProviderConfiguration converts the provider configuration into the Terraform data model.
+func flattenVaultProviderConfiguration(c *vaultConfiguration) *credentialsProviderConfigVaultModel {
+	ret := &credentialsProviderConfigVaultModel{
+		URL:                 types.StringValue(c.VaultURL),
+		CredentialsID:       optionalStringValue(c.VaultCredentialID),
+		Namespace:           optionalStringValue(c.VaultNamespace),
+		PrefixPath:          optionalStringValue(c.PrefixPath),
+		EngineVersion:       types.Int64Value(c.EngineVersion),
+		SkipSslVerification: types.BoolValue(c.SkipSslVerification),
+		Timeout:             types.Int64Value(c.Timeout),
+	}
+
+	// Defaults are omitted from the export
+	if c.EngineVersion == 0 {
+		ret.EngineVersion = types.Int64Value(2)
+	}
+	if c.Timeout == 0 {
+		ret.Timeout = types.Int64Value(60)
+	}
+
+	return ret
+}
+
+// optionalStringValue converts an empty string into a null value, matching an unset optional attribute.
+func optionalStringValue(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
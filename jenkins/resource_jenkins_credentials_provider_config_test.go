@@ -0,0 +1,111 @@
+package jenkins
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJenkinsCredentialsProviderConfig_vault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_credentials_provider_config vault {
+				  vault = {
+				    url            = "https://vault.example.com"
+				    credentials_id = "vault-approle"
+				  }
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_credentials_provider_config.vault", "id", "vault"),
+					resource.TestCheckResourceAttr("jenkins_credentials_provider_config.vault", "vault.url", "https://vault.example.com"),
+					resource.TestCheckResourceAttr("jenkins_credentials_provider_config.vault", "vault.engine_version", "2"),
+				),
+			},
+			{
+				Config: `
+				resource jenkins_credentials_provider_config vault {
+				  vault = {
+				    url            = "https://vault.example.com"
+				    credentials_id = "vault-approle"
+				    namespace      = "jenkins"
+				    engine_version = 1
+				  }
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_credentials_provider_config.vault", "vault.namespace", "jenkins"),
+					resource.TestCheckResourceAttr("jenkins_credentials_provider_config.vault", "vault.engine_version", "1"),
+				),
+			},
+			{
+				ResourceName:      "jenkins_credentials_provider_config.vault",
+				ImportState:       true,
+				ImportStateId:     "vault",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJenkinsCredentialsProviderConfig_kubernetes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_credentials_provider_config kubernetes {
+				  kubernetes = {
+				    label_selector = "jenkins.io/team=platform"
+				  }
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_credentials_provider_config.kubernetes", "id", "kubernetes"),
+					resource.TestCheckResourceAttr("jenkins_credentials_provider_config.kubernetes", "kubernetes.label_selector", "jenkins.io/team=platform"),
+					resource.TestCheckNoResourceAttr("jenkins_credentials_provider_config.kubernetes", "kubernetes.namespace"),
+				),
+			},
+			{
+				Config: `
+				resource jenkins_credentials_provider_config kubernetes {
+				  kubernetes = {
+				    namespace = "jenkins-secrets"
+				  }
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_credentials_provider_config.kubernetes", "kubernetes.namespace", "jenkins-secrets"),
+					resource.TestCheckNoResourceAttr("jenkins_credentials_provider_config.kubernetes", "kubernetes.label_selector"),
+				),
+			},
+			{
+				ResourceName:      "jenkins_credentials_provider_config.kubernetes",
+				ImportState:       true,
+				ImportStateId:     "kubernetes",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJenkinsCredentialsProviderConfig_awsEmptyFilters(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource jenkins_credentials_provider_config aws {
+				  aws_secrets_manager = {
+				    region  = "us-east-1"
+				    filters = []
+				  }
+				}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+			},
+		},
+	})
+}
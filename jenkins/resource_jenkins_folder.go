@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -58,12 +59,158 @@ func resourceJenkinsFolder() *schema.Resource {
 						},
 						"permissions": {
 							Type:        schema.TypeList,
-							Required:    true,
-							Description: "The Jenkins permissions sets that provide access to this folder.",
+							Optional:    true,
+							Description: "The raw Jenkins permissions sets that provide access to this folder, such as `hudson.model.Item.Read:alice`.",
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
 						},
+						"permission": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "The permissions granted to a single user or group.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"principal": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The name of the user or group being granted permissions.",
+									},
+									"type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Description:      "The type of the principal, either `USER` or `GROUP`. If not set, the permissions apply to any user or group with a matching name.",
+										ValidateDiagFunc: validateFolderPermissionType,
+									},
+									"permissions": {
+										Type:        schema.TypeSet,
+										Required:    true,
+										Description: "The short names of the permissions granted to the principal, such as `Job/Read`.",
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: validateFolderPermissionName,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"environment_variables": {
+				Type:        schema.TypeMap,
+				Description: "Environment variables made available to all jobs within this folder.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"pipeline_library": {
+				Type:        schema.TypeList,
+				Description: "The Pipeline shared libraries made available to all jobs within this folder. If never set, any libraries configured within Jenkins will be left untouched. Removing every block removes the libraries from the folder.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name used to reference the library from a Jenkinsfile.",
+							Required:    true,
+						},
+						"default_version": {
+							Type:        schema.TypeString,
+							Description: "The branch, tag or commit to load when no version is requested.",
+							Optional:    true,
+						},
+						"implicit": {
+							Type:        schema.TypeBool,
+							Description: "Whether the library is loaded automatically, without an explicit `@Library` annotation.",
+							Optional:    true,
+							Default:     false,
+						},
+						"allow_version_override": {
+							Type:        schema.TypeBool,
+							Description: "Whether jobs may request a version other than the default.",
+							Optional:    true,
+							Default:     true,
+						},
+						"include_in_changesets": {
+							Type:        schema.TypeBool,
+							Description: "Whether changes to the library are included in the changesets of builds.",
+							Optional:    true,
+							Default:     true,
+						},
+						"remote": {
+							Type:        schema.TypeString,
+							Description: "The Git repository URL to retrieve the library from.",
+							Optional:    true,
+						},
+						"credentials_id": {
+							Type:        schema.TypeString,
+							Description: "The ID of the credentials used to check out the Git repository.",
+							Optional:    true,
+						},
+						"caching": {
+							Type:        schema.TypeList,
+							Description: "Enables caching of the library on the controller.",
+							Optional:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"refresh_time_minutes": {
+										Type:        schema.TypeInt,
+										Description: "The age in minutes after which the cache is refreshed. If `0` the cache is never refreshed.",
+										Optional:    true,
+									},
+									"excluded_versions": {
+										Type:        schema.TypeString,
+										Description: "A space separated list of versions that should never be cached.",
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"docker_label": {
+				Type:        schema.TypeString,
+				Description: "The agent label used by Declarative Pipelines in this folder when running Docker stages.",
+				Optional:    true,
+			},
+			"kubernetes_permitted_clouds": {
+				Type:        schema.TypeSet,
+				Description: "The Kubernetes clouds that jobs within this folder are permitted to use.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"primary_view": {
+				Type:        schema.TypeString,
+				Description: "The name of the view shown by default when opening the folder. If not set, the view selected within Jenkins will be left untouched.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"health_metrics": {
+				Type:        schema.TypeList,
+				Description: "The metrics used to calculate the health of the folder. If not set, the metrics configured within Jenkins will be left untouched.",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"worst_child_health": {
+							Type:        schema.TypeBool,
+							Description: "Whether the folder reports the health of its least healthy child item.",
+							Optional:    true,
+							Default:     true,
+						},
+						"recursive": {
+							Type:        schema.TypeBool,
+							Description: "Whether the worst child health metric also considers items within subfolders.",
+							Optional:    true,
+							Default:     true,
+						},
 					},
 				},
 			},
@@ -91,6 +238,8 @@ func resourceJenkinsFolderCreate(ctx context.Context, d *schema.ResourceData, me
 		DisplayName: d.Get("display_name").(string),
 	}
 	f.Properties.Security = expandSecurity(d.Get("security").(*schema.Set).List())
+	expandFolderProperties(d, &f.Properties)
+	expandFolderViews(d, &f)
 
 	xml, err := f.Render()
 	if err != nil {
@@ -161,7 +310,43 @@ func resourceJenkinsFolderRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set("security", flattenSecurity(f.Properties.Security)); err != nil {
+	if err := d.Set("security", flattenSecurity(f.Properties.Security, securityUsesPermissionBlocks(d), securityRawPermissions(d))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("environment_variables", f.Properties.EnvVars.parseEnvVars()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Libraries may be managed by the jenkins_folder_pipeline_library resource instead,
+	// so only read them back once they are managed by this resource
+	if len(d.Get("pipeline_library").([]interface{})) > 0 {
+		if err := d.Set("pipeline_library", flattenPipelineLibraries(f.Properties.Libraries)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	dockerLabel := ""
+	if f.Properties.Docker != nil {
+		dockerLabel = f.Properties.Docker.DockerLabel
+	}
+	if err := d.Set("docker_label", dockerLabel); err != nil {
+		return diag.FromErr(err)
+	}
+
+	permittedClouds := []string{}
+	if f.Properties.Kubernetes != nil {
+		permittedClouds = f.Properties.Kubernetes.PermittedClouds
+	}
+	if err := d.Set("kubernetes_permitted_clouds", permittedClouds); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("primary_view", f.FolderViews.PrimaryView); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("health_metrics", flattenHealthMetrics(&f.HealthMetrics)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -172,6 +357,10 @@ func resourceJenkinsFolderUpdate(ctx context.Context, d *schema.ResourceData, me
 	client := meta.(jenkinsClient)
 	name, folders := parseCanonicalJobID(d.Id())
 
+	// Prevent concurrent modifications from jenkins_folder_pipeline_library resources
+	folderConfigMutex.Lock()
+	defer folderConfigMutex.Unlock()
+
 	// grab job by current name
 	job, err := client.GetJob(ctx, name, folders...)
 	if err != nil {
@@ -194,6 +383,8 @@ func resourceJenkinsFolderUpdate(ctx context.Context, d *schema.ResourceData, me
 	f.Description = d.Get("description").(string)
 	f.DisplayName = d.Get("display_name").(string)
 	f.Properties.Security = expandSecurity(d.Get("security").(*schema.Set).List())
+	expandFolderProperties(d, &f.Properties)
+	expandFolderViews(d, f)
 
 	// And send it back to Jenkins
 	xml, err := f.Render()
@@ -225,7 +416,7 @@ func resourceJenkinsFolderDelete(ctx context.Context, d *schema.ResourceData, me
 }
 
 func expandSecurity(config []interface{}) *folderSecurity {
-	if len(config) == 0 {
+	if len(config) == 0 || config[0] == nil {
 		return nil
 	}
 
@@ -234,22 +425,261 @@ func expandSecurity(config []interface{}) *folderSecurity {
 	ret.InheritanceStrategy = folderPermissionInheritanceStrategy{
 		Class: data["inheritance_strategy"].(string),
 	}
-	ret.Permission = []string{}
+
+	entries := map[string]bool{}
 	for _, permission := range data["permissions"].([]interface{}) {
-		ret.Permission = append(ret.Permission, permission.(string))
+		entries[permission.(string)] = true
+	}
+	for _, block := range data["permission"].(*schema.Set).List() {
+		block := block.(map[string]interface{})
+		for _, name := range block["permissions"].(*schema.Set).List() {
+			entry := folderPermission{
+				Type:       block["type"].(string),
+				Permission: folderPermissionCatalog[name.(string)],
+				Principal:  block["principal"].(string),
+			}
+			entries[entry.String()] = true
+		}
 	}
+
+	// Jenkins does not retain the order of the permissions, so sort them for a stable configuration
+	ret.Permission = []string{}
+	for entry := range entries {
+		ret.Permission = append(ret.Permission, entry)
+	}
+	sort.Strings(ret.Permission)
 	return ret
 }
 
-func flattenSecurity(config *folderSecurity) []map[string]interface{} {
+// flattenSecurity converts the folder authorization matrix into its Terraform representation.
+// If structured is set, permissions known to the catalog are grouped into "permission" blocks
+// and only the remaining entries are returned as raw "permissions" strings. Entries within raw
+// are always returned as raw strings, so that they remain where they were configured.
+func flattenSecurity(config *folderSecurity, structured bool, raw map[string]bool) []map[string]interface{} {
 	ret := []map[string]interface{}{}
 	if config == nil {
 		return ret
 	}
 
+	permissions := []string{}
+	blocks := map[string]map[string]interface{}{}
+	keys := []string{}
+	for _, entry := range config.Permission {
+		p := parseFolderPermission(entry)
+		name := p.shortName()
+		if !structured || name == "" || raw[entry] {
+			permissions = append(permissions, entry)
+			continue
+		}
+
+		key := p.Type + ":" + p.Principal
+		if _, ok := blocks[key]; !ok {
+			blocks[key] = map[string]interface{}{
+				"principal":   p.Principal,
+				"type":        p.Type,
+				"permissions": []string{},
+			}
+			keys = append(keys, key)
+		}
+		blocks[key]["permissions"] = append(blocks[key]["permissions"].([]string), name)
+	}
+
+	permissionBlocks := []map[string]interface{}{}
+	for _, key := range keys {
+		permissionBlocks = append(permissionBlocks, blocks[key])
+	}
+
 	d := map[string]interface{}{}
 	d["inheritance_strategy"] = config.InheritanceStrategy.Class
-	d["permissions"] = config.Permission
+	d["permissions"] = permissions
+	d["permission"] = permissionBlocks
 
 	return append(ret, d)
 }
+
+// securityRawPermissions returns the raw permission strings configured for the folder security.
+func securityRawPermissions(d *schema.ResourceData) map[string]bool {
+	ret := map[string]bool{}
+	for _, config := range d.Get("security").(*schema.Set).List() {
+		if config == nil {
+			continue
+		}
+		for _, permission := range config.(map[string]interface{})["permissions"].([]interface{}) {
+			if permission, ok := permission.(string); ok {
+				ret[permission] = true
+			}
+		}
+	}
+	return ret
+}
+
+// securityUsesPermissionBlocks reports whether the folder security is managed through
+// "permission" blocks rather than raw permission strings.
+func securityUsesPermissionBlocks(d *schema.ResourceData) bool {
+	for _, config := range d.Get("security").(*schema.Set).List() {
+		if config == nil {
+			continue
+		}
+		if config.(map[string]interface{})["permission"].(*schema.Set).Len() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// expandFolderProperties applies the typed folder properties onto the parsed folder
+// configuration. Properties that are not managed by this resource are left untouched.
+func expandFolderProperties(d *schema.ResourceData, props *folderProperties) {
+	envVars := map[string]string{}
+	for key, value := range d.Get("environment_variables").(map[string]interface{}) {
+		envVars[key] = value.(string)
+	}
+	plugin := ""
+	if props.EnvVars != nil {
+		plugin = props.EnvVars.Plugin
+	}
+	props.EnvVars = renderEnvVars(envVars)
+	if props.EnvVars != nil {
+		props.EnvVars.Plugin = plugin
+	}
+
+	// Libraries may be managed by the jenkins_folder_pipeline_library resource instead,
+	// so only enforce them when they have been explicitly changed
+	if d.IsNewResource() || d.HasChange("pipeline_library") {
+		props.Libraries = expandPipelineLibraries(d.Get("pipeline_library").([]interface{}), props.Libraries)
+	}
+
+	dockerLabel := d.Get("docker_label").(string)
+	if props.Docker == nil && dockerLabel != "" {
+		props.Docker = &folderDocker{}
+	}
+	if props.Docker != nil {
+		props.Docker.DockerLabel = dockerLabel
+		if dockerLabel == "" && len(props.Docker.Other) == 0 {
+			props.Docker = nil
+		}
+	}
+
+	permittedClouds := []string{}
+	for _, cloud := range d.Get("kubernetes_permitted_clouds").(*schema.Set).List() {
+		permittedClouds = append(permittedClouds, cloud.(string))
+	}
+	sort.Strings(permittedClouds)
+	if len(permittedClouds) == 0 {
+		props.Kubernetes = nil
+	} else if props.Kubernetes == nil {
+		props.Kubernetes = &folderKubernetes{PermittedClouds: permittedClouds}
+	} else {
+		props.Kubernetes.PermittedClouds = permittedClouds
+	}
+}
+
+func expandPipelineLibraries(config []interface{}, existing *pipelineLibraries) *pipelineLibraries {
+	if len(config) == 0 {
+		return nil
+	}
+
+	ret := &pipelineLibraries{}
+	if existing != nil {
+		ret.Plugin = existing.Plugin
+	}
+
+	for _, item := range config {
+		data := item.(map[string]interface{})
+
+		// Start from the existing library, if any, to retain unmanaged settings
+		lib := pipelineLibrary{Name: data["name"].(string)}
+		if existing != nil {
+			if i := existing.find(lib.Name); i >= 0 {
+				lib = existing.Libraries[i]
+			}
+		}
+
+		lib.DefaultVersion = data["default_version"].(string)
+		lib.Implicit = data["implicit"].(bool)
+		lib.AllowVersionOverride = data["allow_version_override"].(bool)
+		lib.IncludeInChangesets = data["include_in_changesets"].(bool)
+		lib.setSCM(data["remote"].(string), data["credentials_id"].(string))
+
+		var caching *pipelineLibraryCaching
+		if c := data["caching"].([]interface{}); len(c) > 0 {
+			caching = &pipelineLibraryCaching{}
+			if c[0] != nil {
+				cachingData := c[0].(map[string]interface{})
+				caching.RefreshTimeMinutes = cachingData["refresh_time_minutes"].(int)
+				caching.ExcludedVersionsStr = cachingData["excluded_versions"].(string)
+			}
+		}
+		lib.setCaching(caching)
+
+		ret.Libraries = append(ret.Libraries, lib)
+	}
+
+	return ret
+}
+
+func flattenPipelineLibraries(config *pipelineLibraries) []map[string]interface{} {
+	ret := []map[string]interface{}{}
+	if config == nil {
+		return ret
+	}
+
+	for _, lib := range config.Libraries {
+		remote, credentialsID := lib.remote()
+
+		d := map[string]interface{}{}
+		d["name"] = lib.Name
+		d["default_version"] = lib.DefaultVersion
+		d["implicit"] = lib.Implicit
+		d["allow_version_override"] = lib.AllowVersionOverride
+		d["include_in_changesets"] = lib.IncludeInChangesets
+		d["remote"] = remote
+		d["credentials_id"] = credentialsID
+		d["caching"] = []map[string]interface{}{}
+		if lib.CachingConfiguration != nil {
+			d["caching"] = []map[string]interface{}{
+				{
+					"refresh_time_minutes": lib.CachingConfiguration.RefreshTimeMinutes,
+					"excluded_versions":    lib.CachingConfiguration.ExcludedVersionsStr,
+				},
+			}
+		}
+
+		ret = append(ret, d)
+	}
+
+	return ret
+}
+
+// expandFolderViews applies the primary view and health metrics onto the parsed folder
+// configuration. As both are computed, they are only enforced once they have been configured.
+func expandFolderViews(d *schema.ResourceData, f *folder) {
+	if primaryView := d.Get("primary_view").(string); primaryView != "" {
+		f.FolderViews.PrimaryView = primaryView
+	}
+
+	if config := d.Get("health_metrics").([]interface{}); len(config) > 0 {
+		// Retain the plugin version recorded against the existing metric
+		existing := f.HealthMetrics.WorstChild
+		f.HealthMetrics.WorstChild = nil
+		if config[0] != nil {
+			data := config[0].(map[string]interface{})
+			if data["worst_child_health"].(bool) {
+				f.HealthMetrics.WorstChild = &folderWorstChildHealthMetric{
+					NonRecursive: !data["recursive"].(bool),
+				}
+				if existing != nil {
+					f.HealthMetrics.WorstChild.Plugin = existing.Plugin
+				}
+			}
+		}
+	}
+}
+
+func flattenHealthMetrics(config *folderHealthMetrics) []map[string]interface{} {
+	d := map[string]interface{}{}
+	d["worst_child_health"] = config.WorstChild != nil
+	d["recursive"] = config.WorstChild == nil || !config.WorstChild.NonRecursive
+
+	return []map[string]interface{}{d}
+}
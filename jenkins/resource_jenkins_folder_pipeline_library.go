@@ -0,0 +1,326 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	jenkins "github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// folderConfigMutex serializes modifications of folder configurations, as each library
+// is written by fetching and re-submitting the entire folder config.xml.
+var folderConfigMutex sync.Mutex
+
+type folderPipelineLibraryResourceModel struct {
+	ID                   types.String                 `tfsdk:"id"`
+	Name                 types.String                 `tfsdk:"name"`
+	Folder               types.String                 `tfsdk:"folder"`
+	DefaultVersion       types.String                 `tfsdk:"default_version"`
+	Implicit             types.Bool                   `tfsdk:"implicit"`
+	AllowVersionOverride types.Bool                   `tfsdk:"allow_version_override"`
+	IncludeInChangesets  types.Bool                   `tfsdk:"include_in_changesets"`
+	Remote               types.String                 `tfsdk:"remote"`
+	CredentialsID        types.String                 `tfsdk:"credentials_id"`
+	Caching              *pipelineLibraryCachingModel `tfsdk:"caching"`
+}
+
+type folderPipelineLibraryResource struct {
+	*resourceHelper
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ resource.ResourceWithConfigure = &folderPipelineLibraryResource{}
+var _ resource.ResourceWithImportState = &folderPipelineLibraryResource{}
+
+func newFolderPipelineLibraryResource() resource.Resource {
+	return &folderPipelineLibraryResource{
+		resourceHelper: newResourceHelper(),
+	}
+}
+
+// Metadata should return the full name of the resource.
+func (r *folderPipelineLibraryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder_pipeline_library"
+}
+
+// Schema should return the schema for this resource.
+func (r *folderPipelineLibraryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manages a single Pipeline shared library within the properties of a folder. Any other libraries or properties of the folder are left untouched.
+
+~> Do not use this resource alongside the "pipeline_library" property of the "jenkins_folder" resource for the same folder, or the two will fight over the folder configuration.
+
+~> The Jenkins installation that uses this resource is expected to have the [Pipeline: Groovy Libraries Plugin](https://plugins.jenkins.io/pipeline-groovy-lib/) installed in their system.`,
+		Attributes: r.schemaPipelineLibrary(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The folder and library name, e.g. `/job/folder-name/library-name`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"folder": schema.StringAttribute{
+				MarkdownDescription: "The folder to add the library to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		}),
+	}
+}
+
+// Create is called when the provider must create a new resource. Config
+// and planned state values should be read from the
+// CreateRequest and new state values set on the CreateResponse.
+func (r *folderPipelineLibraryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data folderPipelineLibraryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.updateFolderLibraries(ctx, data.Folder.ValueString(), func(libs *pipelineLibraries) error {
+		if libs.find(data.Name.ValueString()) >= 0 {
+			return fmt.Errorf("library %q already exists in folder %q", data.Name.ValueString(), data.Folder.ValueString())
+		}
+
+		lib := pipelineLibrary{Name: data.Name.ValueString()}
+		data.expand(&lib)
+		libs.Libraries = append(libs.Libraries, lib)
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while creating the resource. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Convert from the API data model to the Terraform data model
+	// and set any unknown attribute values.
+	data.ID = types.StringValue(data.Folder.ValueString() + "/" + data.Name.ValueString())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called when the provider must read resource values in order
+// to update state. Planned state values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (r *folderPipelineLibraryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data folderPipelineLibraryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	f, _, err := r.getFolder(ctx, data.Folder.ValueString())
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "404") {
+			// Folder does not exist
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while parsing the resource read response. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	i := -1
+	if f.Properties.Libraries != nil {
+		i = f.Properties.Libraries.find(data.Name.ValueString())
+	}
+	if i < 0 {
+		// Library does not exist
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(data.Folder.ValueString() + "/" + data.Name.ValueString())
+	data.flatten(&f.Properties.Libraries.Libraries[i])
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is called to update the state of the resource. Config, planned
+// state, and prior state values should be read from the
+// UpdateRequest and new state values set on the UpdateResponse.
+func (r *folderPipelineLibraryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data folderPipelineLibraryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.updateFolderLibraries(ctx, data.Folder.ValueString(), func(libs *pipelineLibraries) error {
+		i := libs.find(data.Name.ValueString())
+		if i < 0 {
+			return fmt.Errorf("library %q no longer exists in folder %q", data.Name.ValueString(), data.Folder.ValueString())
+		}
+
+		data.expand(&libs.Libraries[i])
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *folderPipelineLibraryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data folderPipelineLibraryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	err := r.updateFolderLibraries(ctx, data.Folder.ValueString(), func(libs *pipelineLibraries) error {
+		if i := libs.find(data.Name.ValueString()); i >= 0 {
+			libs.Libraries = append(libs.Libraries[:i], libs.Libraries[i+1:]...)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while deleting the resource. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ImportState is called when performing import operations of existing resources.
+func (r *folderPipelineLibraryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	folders := extractFolders(req.ID)
+	if len(folders) < 2 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: \"<folder>/<name>\". Got: %q", req.ID),
+		)
+		return
+	}
+
+	name := folders[len(folders)-1]
+	folder := formatFolderID(folders[:len(folders)-1])
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("folder"), folder)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), folder+"/"+name)...)
+}
+
+// getFolder retrieves and parses the configuration of the given folder.
+func (r *folderPipelineLibraryResource) getFolder(ctx context.Context, folderName string) (*folder, *jenkins.Job, error) {
+	name, folders := parseCanonicalJobID(formatFolderName(folderName))
+	job, err := r.client.GetJob(ctx, name, folders...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	config, err := job.GetConfig(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not extract configuration of folder %q: %w", folderName, err)
+	}
+
+	f, err := parseFolder(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return f, job, nil
+}
+
+// updateFolderLibraries applies the given modification to the libraries of a folder and
+// submits the resulting configuration back to Jenkins.
+func (r *folderPipelineLibraryResource) updateFolderLibraries(ctx context.Context, folderName string, fn func(libs *pipelineLibraries) error) error {
+	folderConfigMutex.Lock()
+	defer folderConfigMutex.Unlock()
+
+	f, job, err := r.getFolder(ctx, folderName)
+	if err != nil {
+		return err
+	}
+
+	if f.Properties.Libraries == nil {
+		f.Properties.Libraries = &pipelineLibraries{}
+	}
+	if err := fn(f.Properties.Libraries); err != nil {
+		return err
+	}
+	if len(f.Properties.Libraries.Libraries) == 0 {
+		f.Properties.Libraries = nil
+	}
+
+	xml, err := f.Render()
+	if err != nil {
+		return fmt.Errorf("could not render configuration of folder %q: %w", folderName, err)
+	}
+
+	return job.UpdateConfig(ctx, string(xml))
+}
+
+// expand applies the Terraform data model onto the given library.
+func (m *folderPipelineLibraryResourceModel) expand(lib *pipelineLibrary) {
+	lib.DefaultVersion = m.DefaultVersion.ValueString()
+	lib.Implicit = m.Implicit.ValueBool()
+	lib.AllowVersionOverride = m.AllowVersionOverride.ValueBool()
+	lib.IncludeInChangesets = m.IncludeInChangesets.ValueBool()
+	lib.setSCM(m.Remote.ValueString(), m.CredentialsID.ValueString())
+	lib.setCaching(m.Caching.expand())
+}
+
+// flatten populates the Terraform data model from the given library.
+func (m *folderPipelineLibraryResourceModel) flatten(lib *pipelineLibrary) {
+	remote, credentialsID := lib.remote()
+
+	m.Name = types.StringValue(lib.Name)
+	m.DefaultVersion = types.StringValue(lib.DefaultVersion)
+	m.Implicit = types.BoolValue(lib.Implicit)
+	m.AllowVersionOverride = types.BoolValue(lib.AllowVersionOverride)
+	m.IncludeInChangesets = types.BoolValue(lib.IncludeInChangesets)
+	m.Remote = types.StringValue(remote)
+	m.CredentialsID = types.StringValue(credentialsID)
+	m.Caching = flattenPipelineLibraryCaching(lib.CachingConfiguration)
+}
@@ -0,0 +1,106 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccJenkinsFolderPipelineLibrary_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckJenkinsFolderPipelineLibraryDestroy,
+			testAccCheckJenkinsFolderDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_folder foo {
+				  name = "tf-acc-test-%s"
+				}
+
+				resource jenkins_folder_pipeline_library foo {
+				  name            = "shared"
+				  folder          = jenkins_folder.foo.id
+				  default_version = "main"
+				  remote          = "https://github.com/example/shared-library.git"
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_folder_pipeline_library.foo", "id", "/job/tf-acc-test-"+randString+"/shared"),
+					resource.TestCheckResourceAttr("jenkins_folder_pipeline_library.foo", "implicit", "false"),
+					resource.TestCheckResourceAttr("jenkins_folder_pipeline_library.foo", "allow_version_override", "true"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_folder foo {
+				  name = "tf-acc-test-%s"
+				}
+
+				resource jenkins_folder_pipeline_library foo {
+				  name            = "shared"
+				  folder          = jenkins_folder.foo.id
+				  default_version = "v2"
+				  implicit        = true
+				  remote          = "https://github.com/example/shared-library.git"
+
+				  caching = {
+				    refresh_time_minutes = 60
+				  }
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_folder_pipeline_library.foo", "default_version", "v2"),
+					resource.TestCheckResourceAttr("jenkins_folder_pipeline_library.foo", "implicit", "true"),
+					resource.TestCheckResourceAttr("jenkins_folder_pipeline_library.foo", "caching.refresh_time_minutes", "60"),
+				),
+			},
+			{
+				ResourceName:      "jenkins_folder_pipeline_library.foo",
+				ImportState:       true,
+				ImportStateId:     "/job/tf-acc-test-" + randString + "/shared",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckJenkinsFolderPipelineLibraryDestroy(s *terraform.State) error {
+	ctx := context.Background()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "jenkins_folder_pipeline_library" {
+			continue
+		}
+
+		name, folders := parseCanonicalJobID(rs.Primary.Attributes["folder"])
+		job, err := testAccClient.GetJob(ctx, name, folders...)
+		if err != nil {
+			// The folder itself has been removed
+			continue
+		}
+
+		config, err := job.GetConfig(ctx)
+		if err != nil {
+			return err
+		}
+
+		f, err := parseFolder(config)
+		if err != nil {
+			return err
+		}
+
+		if f.Properties.Libraries != nil && f.Properties.Libraries.find(rs.Primary.Attributes["name"]) >= 0 {
+			return fmt.Errorf("Library %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
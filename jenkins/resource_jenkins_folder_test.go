@@ -3,8 +3,10 @@ package jenkins
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -93,6 +95,232 @@ func TestAccJenkinsFolder_nested(t *testing.T) {
 	})
 }
 
+func TestAccJenkinsFolder_pipelineLibrary(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		CheckDestroy:             testAccCheckJenkinsFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_folder foo {
+				  name = "tf-acc-test-%s"
+
+				  pipeline_library {
+				    name            = "shared"
+				    default_version = "main"
+				    remote          = "https://github.com/example/shared-library.git"
+				  }
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_folder.foo", "pipeline_library.#", "1"),
+					resource.TestCheckResourceAttr("jenkins_folder.foo", "pipeline_library.0.name", "shared"),
+					resource.TestCheckResourceAttr("jenkins_folder.foo", "pipeline_library.0.default_version", "main"),
+					resource.TestCheckResourceAttr("jenkins_folder.foo", "pipeline_library.0.remote", "https://github.com/example/shared-library.git"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_folder foo {
+				  name = "tf-acc-test-%s"
+
+				  pipeline_library {
+				    name            = "shared"
+				    default_version = "v2"
+				    implicit        = true
+				    remote          = "https://github.com/example/shared-library.git"
+
+				    caching {
+				      refresh_time_minutes = 60
+				    }
+				  }
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_folder.foo", "pipeline_library.0.default_version", "v2"),
+					resource.TestCheckResourceAttr("jenkins_folder.foo", "pipeline_library.0.implicit", "true"),
+					resource.TestCheckResourceAttr("jenkins_folder.foo", "pipeline_library.0.caching.0.refresh_time_minutes", "60"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_folder foo {
+				  name = "tf-acc-test-%s"
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_folder.foo", "pipeline_library.#", "0"),
+					testAccCheckJenkinsFolderLibraries("jenkins_folder.foo", 0),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckJenkinsFolderLibraries verifies the number of libraries configured on the folder within Jenkins.
+func testAccCheckJenkinsFolderLibraries(resourceName string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%s not found", resourceName)
+		}
+
+		job, err := testAccClient.GetJob(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		config, err := job.GetConfig(context.Background())
+		if err != nil {
+			return err
+		}
+		f, err := parseFolder(config)
+		if err != nil {
+			return err
+		}
+
+		got := 0
+		if f.Properties.Libraries != nil {
+			got = len(f.Properties.Libraries.Libraries)
+		}
+		if got != want {
+			return fmt.Errorf("folder %s has %d libraries, want %d", rs.Primary.ID, got, want)
+		}
+		return nil
+	}
+}
+
+func TestAccJenkinsFolder_permission(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		CheckDestroy:             testAccCheckJenkinsFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_folder foo {
+				  name = "tf-acc-test-%s"
+
+				  security {
+				    permission {
+				      principal   = "authenticated"
+				      type        = "GROUP"
+				      permissions = ["Job/Read", "Job/Build", "Credentials/View"]
+				    }
+
+				    permission {
+				      principal   = "alice"
+				      type        = "USER"
+				      permissions = ["Job/Configure"]
+				    }
+				  }
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_folder.foo", "security.#", "1"),
+					resource.TestCheckResourceAttr("jenkins_folder.foo", "security.0.permission.#", "2"),
+					resource.TestCheckResourceAttr("jenkins_folder.foo", "security.0.permissions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJenkinsFolder_permissionMixed(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		CheckDestroy:             testAccCheckJenkinsFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_folder foo {
+				  name = "tf-acc-test-%s"
+
+				  security {
+				    permissions = ["USER:hudson.model.Item.Configure:alice"]
+
+				    permission {
+				      principal   = "authenticated"
+				      type        = "GROUP"
+				      permissions = ["Job/Read"]
+				    }
+				  }
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_folder.foo", "security.#", "1"),
+					resource.TestCheckResourceAttr("jenkins_folder.foo", "security.0.permission.#", "1"),
+					resource.TestCheckResourceAttr("jenkins_folder.foo", "security.0.permissions.#", "1"),
+					resource.TestCheckResourceAttr("jenkins_folder.foo", "security.0.permissions.0", "USER:hudson.model.Item.Configure:alice"),
+				),
+			},
+			{
+				// Ensure that refreshing does not move the raw entry into a permission block
+				Config: fmt.Sprintf(`
+				resource jenkins_folder foo {
+				  name = "tf-acc-test-%s"
+
+				  security {
+				    permissions = ["USER:hudson.model.Item.Configure:alice"]
+
+				    permission {
+				      principal   = "authenticated"
+				      type        = "GROUP"
+				      permissions = ["Job/Read"]
+				    }
+				  }
+				}`, randString),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func Test_flattenSecurity(t *testing.T) {
+	config := &folderSecurity{
+		InheritanceStrategy: folderPermissionInheritanceStrategy{
+			Class: "org.jenkinsci.plugins.matrixauth.inheritance.InheritParentStrategy",
+		},
+		Permission: []string{
+			"GROUP:hudson.model.Item.Build:authenticated",
+			"GROUP:hudson.model.Item.Read:authenticated",
+			"USER:hudson.model.Item.Configure:alice",
+			"org.example.Custom.Permission:bob",
+		},
+	}
+
+	got := flattenSecurity(config, false, nil)
+	if len(got) != 1 || len(got[0]["permissions"].([]string)) != 4 || len(got[0]["permission"].([]map[string]interface{})) != 0 {
+		t.Errorf("flattenSecurity() unstructured = %v", got)
+	}
+
+	got = flattenSecurity(config, true, nil)
+	if want := []string{"org.example.Custom.Permission:bob"}; !reflect.DeepEqual(got[0]["permissions"], want) {
+		t.Errorf("flattenSecurity() permissions = %v, want %v", got[0]["permissions"], want)
+	}
+	want := []map[string]interface{}{
+		{"principal": "authenticated", "type": "GROUP", "permissions": []string{"Job/Build", "Job/Read"}},
+		{"principal": "alice", "type": "USER", "permissions": []string{"Job/Configure"}},
+	}
+	if !reflect.DeepEqual(got[0]["permission"], want) {
+		t.Errorf("flattenSecurity() permission = %v, want %v", got[0]["permission"], want)
+	}
+
+	// Raw entries that are configured alongside permission blocks are left as raw entries
+	got = flattenSecurity(config, true, map[string]bool{"USER:hudson.model.Item.Configure:alice": true})
+	if want := []string{"USER:hudson.model.Item.Configure:alice", "org.example.Custom.Permission:bob"}; !reflect.DeepEqual(got[0]["permissions"], want) {
+		t.Errorf("flattenSecurity() mixed permissions = %v, want %v", got[0]["permissions"], want)
+	}
+	want = []map[string]interface{}{
+		{"principal": "authenticated", "type": "GROUP", "permissions": []string{"Job/Build", "Job/Read"}},
+	}
+	if !reflect.DeepEqual(got[0]["permission"], want) {
+		t.Errorf("flattenSecurity() mixed permission = %v, want %v", got[0]["permission"], want)
+	}
+}
+
 func testAccCheckJenkinsFolderDestroy(s *terraform.State) error {
 	ctx := context.Background()
 
@@ -109,3 +337,21 @@ func testAccCheckJenkinsFolderDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func Test_expandFolderViews(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceJenkinsFolder().Schema, map[string]interface{}{
+		"health_metrics": []interface{}{
+			map[string]interface{}{"worst_child_health": true, "recursive": false},
+		},
+	})
+
+	f := &folder{HealthMetrics: folderHealthMetrics{
+		WorstChild: &folderWorstChildHealthMetric{Plugin: "cloudbees-folder@6.15"},
+	}}
+	expandFolderViews(d, f)
+
+	want := &folderWorstChildHealthMetric{Plugin: "cloudbees-folder@6.15", NonRecursive: true}
+	if !reflect.DeepEqual(f.HealthMetrics.WorstChild, want) {
+		t.Errorf("expandFolderViews() = %+v, want %+v", f.HealthMetrics.WorstChild, want)
+	}
+}
@@ -0,0 +1,309 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// globalPipelineLibrariesReadScript prints the currently configured global libraries as XML.
+	globalPipelineLibrariesReadScript = `
+import org.jenkinsci.plugins.workflow.libs.GlobalLibraries
+print(jenkins.model.Jenkins.XSTREAM2.toXML(new ArrayList(GlobalLibraries.get().libraries)))`
+
+	// globalPipelineLibrariesWriteScript replaces the global libraries with the given base64 encoded XML.
+	globalPipelineLibrariesWriteScript = `
+import org.jenkinsci.plugins.workflow.libs.GlobalLibraries
+def xml = new String(Base64.decoder.decode('%s'), 'UTF-8')
+GlobalLibraries.get().setLibraries(jenkins.model.Jenkins.XSTREAM2.fromXML(xml))
+print('OK')`
+)
+
+// globalPipelineLibrariesMutex serializes modifications of the global libraries, as each library
+// is written by fetching and re-submitting the entire list.
+var globalPipelineLibrariesMutex sync.Mutex
+
+type globalPipelineLibraryResourceModel struct {
+	ID                   types.String                 `tfsdk:"id"`
+	Name                 types.String                 `tfsdk:"name"`
+	DefaultVersion       types.String                 `tfsdk:"default_version"`
+	Implicit             types.Bool                   `tfsdk:"implicit"`
+	AllowVersionOverride types.Bool                   `tfsdk:"allow_version_override"`
+	IncludeInChangesets  types.Bool                   `tfsdk:"include_in_changesets"`
+	Remote               types.String                 `tfsdk:"remote"`
+	CredentialsID        types.String                 `tfsdk:"credentials_id"`
+	Caching              *pipelineLibraryCachingModel `tfsdk:"caching"`
+}
+
+type globalPipelineLibraryResource struct {
+	*resourceHelper
+}
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ resource.ResourceWithConfigure = &globalPipelineLibraryResource{}
+var _ resource.ResourceWithImportState = &globalPipelineLibraryResource{}
+
+func newGlobalPipelineLibraryResource() resource.Resource {
+	return &globalPipelineLibraryResource{
+		resourceHelper: newResourceHelper(),
+	}
+}
+
+// Metadata should return the full name of the resource.
+func (r *globalPipelineLibraryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_global_pipeline_library"
+}
+
+// Schema should return the schema for this resource.
+func (r *globalPipelineLibraryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+Manages a single Global Pipeline Library within the Jenkins system configuration. Any other global libraries are left untouched.
+
+~> The global library configuration is not exposed through the Jenkins REST API, so this resource uses the script console. The credentials used by the provider must have the "Overall/Administer" permission.
+
+~> The Jenkins installation that uses this resource is expected to have the [Pipeline: Groovy Libraries Plugin](https://plugins.jenkins.io/pipeline-groovy-lib/) installed in their system.`,
+		Attributes: r.schemaPipelineLibrary(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the library.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		}),
+	}
+}
+
+// Create is called when the provider must create a new resource. Config
+// and planned state values should be read from the
+// CreateRequest and new state values set on the CreateResponse.
+func (r *globalPipelineLibraryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data globalPipelineLibraryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.updateGlobalLibraries(ctx, func(libs *pipelineLibraryList) error {
+		if libs.find(data.Name.ValueString()) >= 0 {
+			return fmt.Errorf("global library %q already exists", data.Name.ValueString())
+		}
+
+		lib := pipelineLibrary{Name: data.Name.ValueString()}
+		data.expand(&lib)
+		libs.Libraries = append(libs.Libraries, lib)
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while creating the resource. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Convert from the API data model to the Terraform data model
+	// and set any unknown attribute values.
+	data.ID = data.Name
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called when the provider must read resource values in order
+// to update state. Planned state values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (r *globalPipelineLibraryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data globalPipelineLibraryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	libs, err := r.getGlobalLibraries(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while parsing the resource read response. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	i := libs.find(data.Name.ValueString())
+	if i < 0 {
+		// Library does not exist
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = data.Name
+	data.flatten(&libs.Libraries[i])
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is called to update the state of the resource. Config, planned
+// state, and prior state values should be read from the
+// UpdateRequest and new state values set on the UpdateResponse.
+func (r *globalPipelineLibraryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data globalPipelineLibraryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.updateGlobalLibraries(ctx, func(libs *pipelineLibraryList) error {
+		i := libs.find(data.Name.ValueString())
+		if i < 0 {
+			return fmt.Errorf("global library %q no longer exists", data.Name.ValueString())
+		}
+
+		data.expand(&libs.Libraries[i])
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete is called when the provider must delete the resource. Config
+// values may be read from the DeleteRequest.
+//
+// If execution completes without error, the framework will automatically
+// call DeleteResponse.State.RemoveResource(), so it can be omitted
+// from provider logic.
+func (r *globalPipelineLibraryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data globalPipelineLibraryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	err := r.updateGlobalLibraries(ctx, func(libs *pipelineLibraryList) error {
+		if i := libs.find(data.Name.ValueString()); i >= 0 {
+			libs.Libraries = append(libs.Libraries[:i], libs.Libraries[i+1:]...)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while deleting the resource. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+// ImportState is called when performing import operations of existing resources.
+func (r *globalPipelineLibraryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// getGlobalLibraries retrieves the libraries configured in the Jenkins system configuration.
+func (r *globalPipelineLibraryResource) getGlobalLibraries(ctx context.Context) (*pipelineLibraryList, error) {
+	output, err := r.client.RunScript(ctx, globalPipelineLibrariesReadScript)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &pipelineLibraryList{}
+	if err := xml.Unmarshal(handleXml(output), ret); err != nil {
+		return nil, fmt.Errorf("could not parse global libraries: %w: %s", err, strings.TrimSpace(output))
+	}
+
+	return ret, nil
+}
+
+// updateGlobalLibraries applies the given modification to the global libraries and
+// submits the resulting list back to Jenkins.
+func (r *globalPipelineLibraryResource) updateGlobalLibraries(ctx context.Context, fn func(libs *pipelineLibraryList) error) error {
+	globalPipelineLibrariesMutex.Lock()
+	defer globalPipelineLibrariesMutex.Unlock()
+
+	libs, err := r.getGlobalLibraries(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(libs); err != nil {
+		return err
+	}
+
+	payload, err := xml.Marshal(libs)
+	if err != nil {
+		return fmt.Errorf("could not render global libraries: %w", err)
+	}
+
+	script := fmt.Sprintf(globalPipelineLibrariesWriteScript, base64.StdEncoding.EncodeToString(payload))
+	output, err := r.client.RunScript(ctx, script)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(output) != "OK" {
+		return fmt.Errorf("could not update global libraries: %s", strings.TrimSpace(output))
+	}
+
+	return nil
+}
+
+// expand applies the Terraform data model onto the given library.
+func (m *globalPipelineLibraryResourceModel) expand(lib *pipelineLibrary) {
+	lib.DefaultVersion = m.DefaultVersion.ValueString()
+	lib.Implicit = m.Implicit.ValueBool()
+	lib.AllowVersionOverride = m.AllowVersionOverride.ValueBool()
+	lib.IncludeInChangesets = m.IncludeInChangesets.ValueBool()
+	lib.setSCM(m.Remote.ValueString(), m.CredentialsID.ValueString())
+	lib.setCaching(m.Caching.expand())
+}
+
+// flatten populates the Terraform data model from the given library.
+func (m *globalPipelineLibraryResourceModel) flatten(lib *pipelineLibrary) {
+	remote, credentialsID := lib.remote()
+
+	m.Name = types.StringValue(lib.Name)
+	m.DefaultVersion = types.StringValue(lib.DefaultVersion)
+	m.Implicit = types.BoolValue(lib.Implicit)
+	m.AllowVersionOverride = types.BoolValue(lib.AllowVersionOverride)
+	m.IncludeInChangesets = types.BoolValue(lib.IncludeInChangesets)
+	m.Remote = types.StringValue(remote)
+	m.CredentialsID = types.StringValue(credentialsID)
+	m.Caching = flattenPipelineLibraryCaching(lib.CachingConfiguration)
+}
@@ -0,0 +1,56 @@
+package jenkins
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJenkinsGlobalPipelineLibrary_basic(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_global_pipeline_library foo {
+				  name            = "tf-acc-test-%s"
+				  default_version = "main"
+				  remote          = "https://github.com/example/shared-library.git"
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_global_pipeline_library.foo", "id", "tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("jenkins_global_pipeline_library.foo", "default_version", "main"),
+					resource.TestCheckNoResourceAttr("jenkins_global_pipeline_library.foo", "caching"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_global_pipeline_library foo {
+				  name            = "tf-acc-test-%s"
+				  default_version = "v2"
+				  remote          = "https://github.com/example/shared-library.git"
+
+				  caching = {
+				    refresh_time_minutes = 30
+				    excluded_versions    = "main"
+				  }
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_global_pipeline_library.foo", "default_version", "v2"),
+					resource.TestCheckResourceAttr("jenkins_global_pipeline_library.foo", "caching.refresh_time_minutes", "30"),
+					resource.TestCheckResourceAttr("jenkins_global_pipeline_library.foo", "caching.excluded_versions", "main"),
+				),
+			},
+			{
+				ResourceName:      "jenkins_global_pipeline_library.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
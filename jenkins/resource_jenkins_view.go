@@ -2,24 +2,83 @@ package jenkins
 
 import (
 	"context"
+	"encoding/xml"
 	"fmt"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 
-	"github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type ViewResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	Folder           types.String `tfsdk:"folder"`
-	Description      types.String `tfsdk:"description"`
-	AssignedProjects types.List   `tfsdk:"assigned_projects"`
-	URL              types.String `tfsdk:"url"`
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Folder            types.String `tfsdk:"folder"`
+	Type              types.String `tfsdk:"type"`
+	Description       types.String `tfsdk:"description"`
+	AssignedProjects  types.Set    `tfsdk:"assigned_projects"`
+	URL               types.String `tfsdk:"url"`
+	DefaultView       types.String `tfsdk:"default_view"`
+	IncludeStdJobList types.Bool   `tfsdk:"include_std_job_list"`
+	GroupingRules     types.List   `tfsdk:"grouping_rules"`
+	FirstJob          types.String `tfsdk:"first_job"`
+	DisplayedBuilds   types.Int64  `tfsdk:"displayed_builds"`
+	IncludeRegex      types.String `tfsdk:"include_regex"`
+	Recurse           types.Bool   `tfsdk:"recurse"`
+	Columns           types.List   `tfsdk:"columns"`
+	JobFilters        types.List   `tfsdk:"job_filters"`
+}
+
+type ViewGroupingRule struct {
+	GroupRegex types.String `tfsdk:"group_regex"`
+	NamingRule types.String `tfsdk:"naming_rule"`
+}
+
+var viewGroupingRuleType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"group_regex": types.StringType,
+	"naming_rule": types.StringType,
+}}
+
+type ViewJobFilter struct {
+	Class    types.String `tfsdk:"class"`
+	Settings types.Map    `tfsdk:"settings"`
+}
+
+var viewJobFilterType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"class":    types.StringType,
+	"settings": types.MapType{ElemType: types.StringType},
+}}
+
+// viewListTypes are the view types derived from ListView, which share its job selection settings.
+var viewListTypes = []string{"ListView", "Dashboard", "CategorizedJobsView"}
+
+// viewTypeAttributes maps the attributes that only apply to specific view types to those types.
+var viewTypeAttributes = map[string][]string{
+	"assigned_projects":    viewListTypes,
+	"include_regex":        viewListTypes,
+	"recurse":              viewListTypes,
+	"columns":              viewListTypes,
+	"job_filters":          viewListTypes,
+	"default_view":         {"NestedView"},
+	"include_std_job_list": {"Dashboard"},
+	"grouping_rules":       {"CategorizedJobsView"},
+	"first_job":            {"BuildPipelineView"},
+	"displayed_builds":     {"BuildPipelineView"},
 }
 
 type ViewResource struct {
@@ -28,6 +87,9 @@ type ViewResource struct {
 
 // Ensure the implementation satisfies the desired interfaces.
 var _ resource.ResourceWithConfigure = &ViewResource{}
+var _ resource.ResourceWithValidateConfig = &ViewResource{}
+var _ resource.ResourceWithModifyPlan = &ViewResource{}
+var _ resource.ResourceWithImportState = &ViewResource{}
 
 func newViewResource() resource.Resource {
 	return &ViewResource{
@@ -44,34 +106,214 @@ func (r *ViewResource) Metadata(_ context.Context, req resource.MetadataRequest,
 func (r *ViewResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `
-Manages a view within Jenkins.
+Manages a view within Jenkins. Views are managed through their ` + "`config.xml`" + `, so any settings not managed by this resource are retained when it is updated.
 
-~> Due to API client limitations, updates to some attributes may be restricted.`,
+~> View types other than "ListView" and "MyView" require their plugin to be installed in the system: the [Nested View Plugin](https://plugins.jenkins.io/nested-view/), the [Dashboard View Plugin](https://plugins.jenkins.io/dashboard-view/), the [Categorized Jobs View Plugin](https://plugins.jenkins.io/categorized-view/) or the [Build Pipeline Plugin](https://plugins.jenkins.io/build-pipeline-plugin/).`,
 		Attributes: r.schema(map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "The unique name of this view.",
+				MarkdownDescription: "The unique name of this view. Views within folders are identified by their path, e.g. `/job/folder-name/view/view-name`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"assigned_projects": schema.ListAttribute{
-				MarkdownDescription: "The list of projects assigned to the view. For example, the name of a folder.",
+			"assigned_projects": schema.SetAttribute{
+				MarkdownDescription: "The set of projects assigned to the view. For example, the name of a folder. Names are relative to the folder containing the view. Only applies to \"ListView\", \"Dashboard\" and \"CategorizedJobsView\" views.",
 				Optional:            true,
 				ElementType:         types.StringType,
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
-				},
 			},
 			"description": schema.StringAttribute{
-				MarkdownDescription: "The description for the view.",
-				Computed:            true, // No way to update or set description with the gojenkins client at the moment.
+				MarkdownDescription: "The description for the view. If not set then the value within Jenkins is left untouched.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"url": schema.StringAttribute{
 				MarkdownDescription: "The url for the view.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: `The type of the view, one of "` + strings.Join(viewTypes, `", "`) + `". Defaults to "ListView". Changing the type recreates the view.`,
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("ListView"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(viewTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"include_regex": schema.StringAttribute{
+				MarkdownDescription: "A regular expression selecting additional jobs to include in the view by name. Only applies to \"ListView\", \"Dashboard\" and \"CategorizedJobsView\" views. If not set then the value within Jenkins is left untouched.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"recurse": schema.BoolAttribute{
+				MarkdownDescription: "Whether jobs within folders are selected, as well as the top level jobs. Only applies to \"ListView\", \"Dashboard\" and \"CategorizedJobsView\" views. If not set then the value within Jenkins is left untouched.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"columns": schema.ListAttribute{
+				MarkdownDescription: "The classes of the columns shown by the view, in order. For example `hudson.views.StatusColumn` or `hudson.views.JobColumn`. Only applies to \"ListView\", \"Dashboard\" and \"CategorizedJobsView\" views. If not set then the columns within Jenkins are left untouched.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"job_filters": schema.ListNestedAttribute{
+				MarkdownDescription: "Filters narrowing down the jobs of the view, applied in order, such as those provided by the [View Job Filters Plugin](https://plugins.jenkins.io/view-job-filters/). Only applies to \"ListView\", \"Dashboard\" and \"CategorizedJobsView\" views. If not set then the filters within Jenkins are left untouched.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"class": schema.StringAttribute{
+							MarkdownDescription: "The class of the filter, e.g. `hudson.views.RegExJobFilter`.",
+							Required:            true,
+						},
+						"settings": schema.MapAttribute{
+							MarkdownDescription: "The settings of the filter, keyed by the name of their element within the configuration, e.g. `regex`. Settings that nest further elements, such as `otherViews`, cannot be managed and are retained as configured within Jenkins.",
+							Optional:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+			"default_view": schema.StringAttribute{
+				MarkdownDescription: "The name of the nested view shown by default. Only applies to \"NestedView\" views. If not set then the value within Jenkins is left untouched.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"include_std_job_list": schema.BoolAttribute{
+				MarkdownDescription: "Whether the standard list of jobs is shown above the dashboard portlets. Only applies to \"Dashboard\" views. If not set then the value within Jenkins is left untouched.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"grouping_rules": schema.ListNestedAttribute{
+				MarkdownDescription: "Rules grouping the jobs of the view into categories. Only applies to \"CategorizedJobsView\" views. If not set then the rules within Jenkins are left untouched.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group_regex": schema.StringAttribute{
+							MarkdownDescription: "The regular expression matching the names of the jobs within the group.",
+							Required:            true,
+						},
+						"naming_rule": schema.StringAttribute{
+							MarkdownDescription: "The name of the group, which may reference groups of the regular expression such as `$1`.",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"first_job": schema.StringAttribute{
+				MarkdownDescription: "The name of the initial job of the pipeline. Only applies to \"BuildPipelineView\" views. If not set then the value within Jenkins is left untouched.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"displayed_builds": schema.Int64Attribute{
+				MarkdownDescription: "The number of pipeline builds displayed. Only applies to \"BuildPipelineView\" views. If not set then the value within Jenkins is left untouched.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 		}),
 	}
 }
 
+// ValidateConfig ensures that attributes specific to a view type are only set for views of that type.
+func (r *ViewResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ViewResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() {
+		return
+	}
+
+	configured := data.Type.ValueString()
+	if data.Type.IsNull() {
+		configured = "ListView"
+	}
+
+	for attribute, value := range data.typeAttributes() {
+		attributeTypes := viewTypeAttributes[attribute]
+		if value.IsNull() || slices.Contains(attributeTypes, configured) {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("The %q attribute only applies to %q views, but the view type is %q.", attribute, strings.Join(attributeTypes, `", "`), configured),
+		)
+	}
+}
+
+// ModifyPlan marks the type specific attributes that are not configured as unknown when the view
+// type changes, as the values of the previous view do not carry over to the replacement.
+func (r *ViewResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do upon creation or deletion
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan, state ViewResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Type.Equal(state.Type) {
+		return
+	}
+
+	unknown := ViewResourceModel{
+		// Assigned projects are not computed, so they are only ever planned as configured
+		AssignedProjects:  types.SetNull(types.StringType),
+		IncludeRegex:      types.StringUnknown(),
+		Recurse:           types.BoolUnknown(),
+		Columns:           types.ListUnknown(types.StringType),
+		JobFilters:        types.ListUnknown(viewJobFilterType),
+		DefaultView:       types.StringUnknown(),
+		IncludeStdJobList: types.BoolUnknown(),
+		GroupingRules:     types.ListUnknown(viewGroupingRuleType),
+		FirstJob:          types.StringUnknown(),
+		DisplayedBuilds:   types.Int64Unknown(),
+	}.typeAttributes()
+	for attribute, value := range config.typeAttributes() {
+		if value.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attribute), unknown[attribute])...)
+		}
+	}
+}
+
 // Create is called when the provider must create a new resource. Config
 // and planned state values should be read from the
 // CreateRequest and new state values set on the CreateResponse.
@@ -84,14 +326,13 @@ func (r *ViewResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	cm := r.client.Credentials()
-	cm.Folder = formatFolderName(data.Folder.ValueString())
+	folder := formatFolderName(data.Folder.ValueString())
 
 	// Validate that the folder exists
-	if err := folderExists(ctx, r.client, cm.Folder); err != nil {
+	if err := folderExists(ctx, r.client, folder); err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid Folder",
-			fmt.Sprintf("An invalid folder name %q was specified. ", cm.Folder)+
+			fmt.Sprintf("An invalid folder name %q was specified. ", folder)+
 				"Please report this issue to the provider developers.\n\n"+
 				"Error: "+err.Error(),
 		)
@@ -99,8 +340,13 @@ func (r *ViewResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	view, err := cm.J.CreateView(ctx, data.Name.ValueString(), gojenkins.LIST_VIEW)
-	if err != nil {
+	v := newView(data.Type.ValueString(), data.Name.ValueString())
+	resp.Diagnostics.Append(data.expand(ctx, v)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.CreateViewFromConfig(ctx, data.Folder.ValueString(), v); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Resource",
 			"An unexpected error occurred while creating the resource. "+
@@ -111,36 +357,46 @@ func (r *ViewResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	assigedProjects := data.AssignedProjects.Elements()
-	for _, project := range assigedProjects {
-		projectName := strings.Trim(project.String(), "\"")
-		_, err := view.AddJob(ctx, projectName)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Unable to Assign View Projects",
-				fmt.Sprintf("Error adding %q to Jenkins view %q: %s", projectName, data.Name.ValueString(), err),
-			)
+	var assignedProjects []string
+	resp.Diagnostics.Append(data.AssignedProjects.ElementsAs(ctx, &assignedProjects, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-			_, err := cm.J.Requester.Post(ctx, "/view/"+data.Name.ValueString()+"/doDelete", nil, nil, nil)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Unable to Delete Resource",
-					"An unexpected error occurred while deleting the resource. "+
-						"Please report this issue to the provider developers.\n\n"+
-						"Error: "+err.Error(),
-				)
-			}
+	if err := r.updateAssignedProjects(ctx, data.Folder.ValueString(), data.Name.ValueString(), assignedProjects, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Assign View Projects",
+			err.Error(),
+		)
 
-			return
+		if err := r.client.DeleteView(ctx, data.Folder.ValueString(), data.Name.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Resource",
+				"An unexpected error occurred while deleting the resource. "+
+					"Please report this issue to the provider developers.\n\n"+
+					"Error: "+err.Error(),
+			)
 		}
+
+		return
 	}
 
 	// Convert from the API data model to the Terraform data model
 	// and set any unknown attribute values.
-	data.ID = types.StringValue(view.GetName())
-	data.Name = types.StringValue(view.GetName())
-	data.Description = types.StringValue(view.GetDescription())
-	data.URL = types.StringValue(view.GetUrl())
+	v, err := r.client.GetViewConfig(ctx, data.Folder.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while reading the created resource. "+
+				"Please report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+	data.ID = types.StringValue(viewID(data.Folder.ValueString(), v.Name))
+	data.URL = types.StringValue(r.viewURL(data.Folder.ValueString(), v.Name))
+	resp.Diagnostics.Append(data.flatten(ctx, v)...)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -158,13 +414,10 @@ func (r *ViewResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	cm := r.client.Credentials()
-	cm.Folder = formatFolderName(data.Folder.ValueString())
-
-	view, err := cm.J.GetView(ctx, data.ID.ValueString())
+	v, err := r.client.GetViewConfig(ctx, data.Folder.ValueString(), data.Name.ValueString())
 	if err != nil {
 		if strings.HasSuffix(err.Error(), "404") {
-			// Job does not exist
+			// View does not exist
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -177,11 +430,19 @@ func (r *ViewResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		)
 		return
 	}
+	if !slices.Contains(viewTypes, v.Type()) {
+		resp.Diagnostics.AddError(
+			"Unsupported View Type",
+			fmt.Sprintf("The view %q is of class %q, which cannot be managed by this resource. ", data.Name.ValueString(), v.Type())+
+				fmt.Sprintf("Supported view types are %q.", strings.Join(viewTypes, `", "`)),
+		)
+		return
+	}
 
-	data.ID = types.StringValue(view.GetName())
-	data.Name = types.StringValue(view.GetName())
-	data.Description = types.StringValue(view.GetDescription())
-	data.URL = types.StringValue(view.GetUrl())
+	data.ID = types.StringValue(viewID(data.Folder.ValueString(), v.Name))
+	data.Name = types.StringValue(v.Name)
+	data.URL = types.StringValue(r.viewURL(data.Folder.ValueString(), v.Name))
+	resp.Diagnostics.Append(data.flatten(ctx, v)...)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -191,21 +452,91 @@ func (r *ViewResource) Read(ctx context.Context, req resource.ReadRequest, resp
 // state, and prior state values should be read from the
 // UpdateRequest and new state values set on the UpdateResponse.
 func (r *ViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data ViewResourceModel
+	var data, state ViewResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planned, assigned []string
+	resp.Diagnostics.Append(data.AssignedProjects.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(state.AssignedProjects.ElementsAs(ctx, &assigned, false)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Skip any updating of the resource.
-	// No update-functionality in gojenkins.
+	// Filters are only rebuilt when configured, leaving those managed within Jenkins untouched
+	var jobFilters types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("job_filters"), &jobFilters)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if jobFilters.IsNull() {
+		data.JobFilters = types.ListNull(viewJobFilterType)
+	}
+
+	// Apply the changes onto the existing configuration, retaining any unmanaged settings
+	v, err := r.client.GetViewConfig(ctx, data.Folder.ValueString(), data.Name.ValueString())
+	if err == nil {
+		resp.Diagnostics.Append(data.expand(ctx, v)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err = r.client.UpdateViewConfig(ctx, data.Folder.ValueString(), data.Name.ValueString(), v)
+	}
+	if err == nil {
+		// Only assign and unassign the projects that changed, leaving the remainder of the view untouched
+		err = r.updateAssignedProjects(ctx, data.Folder.ValueString(), data.Name.ValueString(),
+			setDifference(planned, assigned), setDifference(assigned, planned))
+	}
+	if err == nil {
+		v, err = r.client.GetViewConfig(ctx, data.Folder.ValueString(), data.Name.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	data.URL = types.StringValue(r.viewURL(data.Folder.ValueString(), v.Name))
+	resp.Diagnostics.Append(data.flatten(ctx, v)...)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// ImportState is called when performing import operations of existing resources.
+func (r *ViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	folder, name, err := parseViewPath(r.client.Server, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: \"[<folder>/]<name>\" or the URL of the view. Got: %q\n\n", req.ID)+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+
+	// Leave the folder unset for views at the root of Jenkins, so that generated configuration matches what would
+	// have been written by hand
+	if folder != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("folder"), folder)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), viewID(folder, name))...)
+}
+
 // Delete is called when the provider must delete the resource. Config
 // values may be read from the DeleteRequest.
 //
@@ -218,11 +549,7 @@ func (r *ViewResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-	cm := r.client.Credentials()
-	cm.Folder = formatFolderName(data.Folder.ValueString())
-
-	_, err := cm.J.Requester.Post(ctx, "/view/"+data.Name.ValueString()+"/doDelete", nil, nil, nil)
-	if err != nil {
+	if err := r.client.DeleteView(ctx, data.Folder.ValueString(), data.Name.ValueString()); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Delete Resource",
 			"An unexpected error occurred while deleting the resource. "+
@@ -233,3 +560,274 @@ func (r *ViewResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 }
+
+// updateAssignedProjects assigns the given projects to the view, and removes the others from it.
+func (r *ViewResource) updateAssignedProjects(ctx context.Context, folder, name string, add, remove []string) error {
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	view, err := r.client.GetViewInFolder(ctx, folder, name)
+	if err != nil {
+		return err
+	}
+
+	for _, project := range add {
+		if _, err := view.AddJob(ctx, project); err != nil {
+			return fmt.Errorf("error adding %q to Jenkins view %q: %w", project, name, err)
+		}
+	}
+	for _, project := range remove {
+		if _, err := view.DeleteJob(ctx, project); err != nil {
+			return fmt.Errorf("error removing %q from Jenkins view %q: %w", project, name, err)
+		}
+	}
+
+	return nil
+}
+
+// setDifference returns the items of a that are not within b, in order.
+func setDifference(a, b []string) []string {
+	ret := []string{}
+	for _, item := range a {
+		if !slices.Contains(b, item) {
+			ret = append(ret, item)
+		}
+	}
+	return ret
+}
+
+// viewURL returns the absolute URL of the given view.
+func (r *ViewResource) viewURL(folder, name string) string {
+	return strings.TrimSuffix(r.client.Server, "/") + viewURL(folder, name) + "/"
+}
+
+// typeAttributes returns the values of the attributes that only apply to specific view types.
+func (m ViewResourceModel) typeAttributes() map[string]attr.Value {
+	return map[string]attr.Value{
+		"assigned_projects":    m.AssignedProjects,
+		"include_regex":        m.IncludeRegex,
+		"recurse":              m.Recurse,
+		"columns":              m.Columns,
+		"job_filters":          m.JobFilters,
+		"default_view":         m.DefaultView,
+		"include_std_job_list": m.IncludeStdJobList,
+		"grouping_rules":       m.GroupingRules,
+		"first_job":            m.FirstJob,
+		"displayed_builds":     m.DisplayedBuilds,
+	}
+}
+
+// expand applies the Terraform data model onto the given view configuration. Attributes
+// that are not set are left untouched.
+func (m *ViewResourceModel) expand(ctx context.Context, v *view) (diags diag.Diagnostics) {
+	if !m.Description.IsNull() && !m.Description.IsUnknown() {
+		v.Description = m.Description.ValueString()
+	}
+	if !m.IncludeRegex.IsNull() && !m.IncludeRegex.IsUnknown() {
+		v.IncludeRegex = m.IncludeRegex.ValueString()
+	}
+	if !m.Recurse.IsNull() && !m.Recurse.IsUnknown() {
+		v.Recurse = m.Recurse.ValueBoolPointer()
+	}
+	if !m.Columns.IsNull() && !m.Columns.IsUnknown() {
+		var classes []string
+		diags.Append(m.Columns.ElementsAs(ctx, &classes, false)...)
+
+		// Retain the settings of the columns that are already present
+		existing := map[string]xmlRawProperty{}
+		if v.Columns != nil {
+			for _, column := range v.Columns.Columns {
+				existing[column.XMLName.Local] = column
+			}
+		}
+
+		v.Columns = &viewColumns{Columns: []xmlRawProperty{}}
+		for _, class := range classes {
+			column, ok := existing[class]
+			if !ok {
+				column = xmlRawProperty{XMLName: xml.Name{Local: class}}
+			}
+			v.Columns.Columns = append(v.Columns.Columns, column)
+		}
+	}
+	if !m.JobFilters.IsNull() && !m.JobFilters.IsUnknown() {
+		var filters []ViewJobFilter
+		diags.Append(m.JobFilters.ElementsAs(ctx, &filters, false)...)
+
+		existing := []viewJobFilter{}
+		if v.JobFilters != nil {
+			existing = v.JobFilters.Filters
+		}
+
+		v.JobFilters = &viewJobFilters{Filters: []viewJobFilter{}}
+		for i, filter := range filters {
+			settings := map[string]string{}
+			diags.Append(filter.Settings.ElementsAs(ctx, &settings, false)...)
+
+			// Filters are matched by position, retaining the plugin and nested settings of an unchanged filter
+			var previous []xmlRawProperty
+			element := viewJobFilter{XMLName: xml.Name{Local: filter.Class.ValueString()}}
+			if i < len(existing) && existing[i].XMLName.Local == element.XMLName.Local {
+				element = existing[i]
+				previous, element.Settings = element.Settings, nil
+			}
+
+			applied := map[string]bool{}
+			for _, setting := range previous {
+				name := setting.XMLName.Local
+				if value, ok := settings[name]; ok {
+					setViewJobFilterSettingValue(&setting, value)
+					applied[name] = true
+				} else if _, ok := viewJobFilterSettingValue(setting); ok {
+					// Text settings that are no longer configured are removed
+					continue
+				}
+				element.Settings = append(element.Settings, setting)
+			}
+
+			names := make([]string, 0, len(settings))
+			for name := range settings {
+				if !applied[name] {
+					names = append(names, name)
+				}
+			}
+			sort.Strings(names)
+
+			for _, name := range names {
+				setting := xmlRawProperty{XMLName: xml.Name{Local: name}}
+				setViewJobFilterSettingValue(&setting, settings[name])
+				element.Settings = append(element.Settings, setting)
+			}
+			v.JobFilters.Filters = append(v.JobFilters.Filters, element)
+		}
+	}
+	if !m.DefaultView.IsNull() && !m.DefaultView.IsUnknown() {
+		v.DefaultView = m.DefaultView.ValueString()
+	}
+	if !m.IncludeStdJobList.IsNull() && !m.IncludeStdJobList.IsUnknown() {
+		v.IncludeStdJobList = m.IncludeStdJobList.ValueBoolPointer()
+	}
+	if !m.GroupingRules.IsNull() && !m.GroupingRules.IsUnknown() {
+		var rules []ViewGroupingRule
+		diags.Append(m.GroupingRules.ElementsAs(ctx, &rules, false)...)
+
+		v.CategorizationCriteria = &viewCategorizationCriteria{}
+		for _, rule := range rules {
+			v.CategorizationCriteria.GroupingRules = append(v.CategorizationCriteria.GroupingRules, viewGroupingRule{
+				GroupRegex: rule.GroupRegex.ValueString(),
+				NamingRule: rule.NamingRule.ValueString(),
+			})
+		}
+	}
+	if !m.FirstJob.IsNull() && !m.FirstJob.IsUnknown() {
+		v.GridBuilder = &viewGridBuilder{
+			Class:    "au.com.centrumsystems.hudson.plugin.buildpipeline.DownstreamProjectGridBuilder",
+			FirstJob: m.FirstJob.ValueString(),
+		}
+	}
+	if !m.DisplayedBuilds.IsNull() && !m.DisplayedBuilds.IsUnknown() {
+		v.NoOfDisplayedBuilds = strconv.FormatInt(m.DisplayedBuilds.ValueInt64(), 10)
+	}
+
+	return diags
+}
+
+// flatten populates the Terraform data model from the given view configuration.
+func (m *ViewResourceModel) flatten(ctx context.Context, v *view) (diags diag.Diagnostics) {
+	m.Type = types.StringValue(v.Type())
+	m.Description = types.StringValue(v.Description)
+
+	m.DefaultView = types.StringNull()
+	m.IncludeStdJobList = types.BoolNull()
+	m.GroupingRules = types.ListNull(viewGroupingRuleType)
+	m.FirstJob = types.StringNull()
+	m.DisplayedBuilds = types.Int64Null()
+	m.IncludeRegex = types.StringNull()
+	m.Recurse = types.BoolNull()
+	m.Columns = types.ListNull(types.StringType)
+	m.JobFilters = types.ListNull(viewJobFilterType)
+
+	if slices.Contains(viewListTypes, m.Type.ValueString()) {
+		// Leave the projects unset when none are assigned, matching the configuration that created the view
+		projects := []string{}
+		if v.JobNames != nil {
+			projects = v.JobNames.Names
+		}
+		if len(projects) > 0 || !m.AssignedProjects.IsNull() {
+			projectSet, d := types.SetValueFrom(ctx, types.StringType, projects)
+			diags.Append(d...)
+			m.AssignedProjects = projectSet
+		}
+
+		m.IncludeRegex = types.StringValue(v.IncludeRegex)
+		m.Recurse = types.BoolValue(v.Recurse != nil && *v.Recurse)
+
+		columns := []string{}
+		if v.Columns != nil {
+			for _, column := range v.Columns.Columns {
+				columns = append(columns, column.XMLName.Local)
+			}
+		}
+		columnList, d := types.ListValueFrom(ctx, types.StringType, columns)
+		diags.Append(d...)
+		m.Columns = columnList
+
+		filters := []ViewJobFilter{}
+		if v.JobFilters != nil {
+			for _, filter := range v.JobFilters.Filters {
+				// Settings that nest further elements cannot be represented, so they are left out
+				values := map[string]attr.Value{}
+				for _, setting := range filter.Settings {
+					if value, ok := viewJobFilterSettingValue(setting); ok {
+						values[setting.XMLName.Local] = types.StringValue(value)
+					}
+				}
+
+				settings := types.MapNull(types.StringType)
+				if len(values) > 0 {
+					settings = types.MapValueMust(types.StringType, values)
+				}
+
+				filters = append(filters, ViewJobFilter{
+					Class:    types.StringValue(filter.XMLName.Local),
+					Settings: settings,
+				})
+			}
+		}
+		filterList, d := types.ListValueFrom(ctx, viewJobFilterType, filters)
+		diags.Append(d...)
+		m.JobFilters = filterList
+	}
+
+	switch m.Type.ValueString() {
+	case "NestedView":
+		m.DefaultView = types.StringValue(v.DefaultView)
+	case "Dashboard":
+		m.IncludeStdJobList = types.BoolValue(v.IncludeStdJobList != nil && *v.IncludeStdJobList)
+	case "CategorizedJobsView":
+		rules := []ViewGroupingRule{}
+		if v.CategorizationCriteria != nil {
+			for _, rule := range v.CategorizationCriteria.GroupingRules {
+				rules = append(rules, ViewGroupingRule{
+					GroupRegex: types.StringValue(rule.GroupRegex),
+					NamingRule: types.StringValue(rule.NamingRule),
+				})
+			}
+		}
+		rulesList, d := types.ListValueFrom(ctx, viewGroupingRuleType, rules)
+		diags.Append(d...)
+		m.GroupingRules = rulesList
+	case "BuildPipelineView":
+		m.FirstJob = types.StringValue("")
+		if v.GridBuilder != nil {
+			m.FirstJob = types.StringValue(v.GridBuilder.FirstJob)
+		}
+		m.DisplayedBuilds = types.Int64Value(1)
+		if builds, err := strconv.ParseInt(v.NoOfDisplayedBuilds, 10, 64); err == nil {
+			m.DisplayedBuilds = types.Int64Value(builds)
+		}
+	}
+
+	return diags
+}
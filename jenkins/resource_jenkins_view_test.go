@@ -3,11 +3,19 @@ package jenkins
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestAccJenkinsView_basic(t *testing.T) {
@@ -26,8 +34,248 @@ func TestAccJenkinsView_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("jenkins_view.foo", "id", "tf-acc-test-"+randString),
 					resource.TestCheckResourceAttr("jenkins_view.foo", "name", "tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("jenkins_view.foo", "type", "ListView"),
 				),
 			},
+			{
+				ResourceName:      "jenkins_view.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJenkinsView_settings(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		CheckDestroy:             testAccCheckJenkinsViewDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_view foo {
+				  name          = "tf-acc-test-%s"
+				  description   = "Initial"
+				  include_regex = "build-.*"
+				  columns       = ["hudson.views.StatusColumn", "hudson.views.JobColumn"]
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_view.foo", "description", "Initial"),
+					resource.TestCheckResourceAttr("jenkins_view.foo", "include_regex", "build-.*"),
+					resource.TestCheckResourceAttr("jenkins_view.foo", "recurse", "false"),
+					resource.TestCheckResourceAttr("jenkins_view.foo", "columns.#", "2"),
+					resource.TestCheckResourceAttr("jenkins_view.foo", "columns.1", "hudson.views.JobColumn"),
+					resource.TestCheckResourceAttr("jenkins_view.foo", "job_filters.#", "0"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_view foo {
+				  name          = "tf-acc-test-%s"
+				  description   = "Updated"
+				  include_regex = "deploy-.*"
+				  recurse       = true
+				  columns       = ["hudson.views.JobColumn", "hudson.views.StatusColumn", "hudson.views.BuildButtonColumn"]
+				}`, randString),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("jenkins_view.foo", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_view.foo", "description", "Updated"),
+					resource.TestCheckResourceAttr("jenkins_view.foo", "include_regex", "deploy-.*"),
+					resource.TestCheckResourceAttr("jenkins_view.foo", "recurse", "true"),
+					resource.TestCheckResourceAttr("jenkins_view.foo", "columns.#", "3"),
+					resource.TestCheckResourceAttr("jenkins_view.foo", "columns.0", "hudson.views.JobColumn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJenkinsView_folder(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		CheckDestroy:             testAccCheckJenkinsViewDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_folder foo {
+				  name = "tf-acc-test-%s"
+				}
+
+				resource jenkins_folder sub {
+				  name   = "subfolder"
+				  folder = jenkins_folder.foo.id
+				}
+
+				resource jenkins_view foo {
+				  name              = "tf-acc-test-%s"
+				  folder            = jenkins_folder.foo.id
+				  assigned_projects = [jenkins_folder.sub.name]
+				}`, randString, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_view.foo", "id", "/job/tf-acc-test-"+randString+"/view/tf-acc-test-"+randString),
+					resource.TestCheckResourceAttr("jenkins_view.foo", "folder", "/job/tf-acc-test-"+randString),
+					resource.TestCheckTypeSetElemAttr("jenkins_view.foo", "assigned_projects.*", "subfolder"),
+				),
+			},
+			{
+				ResourceName:      "jenkins_view.foo",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("tf-acc-test-%s/tf-acc-test-%s", randString, randString),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName: "jenkins_view.foo",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["jenkins_view.foo"].Primary.Attributes["url"], nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJenkinsView_assignedProjects(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	config := func(projects string) string {
+		return fmt.Sprintf(`
+		resource jenkins_folder one {
+		  name = "tf-acc-test-%s-one"
+		}
+
+		resource jenkins_folder two {
+		  name = "tf-acc-test-%s-two"
+		}
+
+		resource jenkins_view foo {
+		  name              = "tf-acc-test-%s"
+		  assigned_projects = [%s]
+		}`, randString, randString, randString, projects)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		CheckDestroy:             testAccCheckJenkinsViewDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config("jenkins_folder.one.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_view.foo", "assigned_projects.#", "1"),
+				),
+			},
+			{
+				Config: config("jenkins_folder.two.name, jenkins_folder.one.name"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("jenkins_view.foo", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("jenkins_view.foo", tfjsonpath.New("id"), knownvalue.StringExact("tf-acc-test-"+randString)),
+						plancheck.ExpectKnownValue("jenkins_view.foo", tfjsonpath.New("url"), knownvalue.NotNull()),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_view.foo", "assigned_projects.#", "2"),
+					resource.TestCheckTypeSetElemAttr("jenkins_view.foo", "assigned_projects.*", "tf-acc-test-"+randString+"-two"),
+				),
+			},
+			{
+				Config: config("jenkins_folder.two.name"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("jenkins_view.foo", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_view.foo", "assigned_projects.#", "1"),
+					resource.TestCheckTypeSetElemAttr("jenkins_view.foo", "assigned_projects.*", "tf-acc-test-"+randString+"-two"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJenkinsView_type(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		CheckDestroy:             testAccCheckJenkinsViewDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_view foo {
+				  name = "tf-acc-test-%s"
+				  type = "Dashboard"
+
+				  include_std_job_list = true
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_view.foo", "type", "Dashboard"),
+					resource.TestCheckResourceAttr("jenkins_view.foo", "include_std_job_list", "true"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_view foo {
+				  name = "tf-acc-test-%s"
+				  type = "Dashboard"
+
+				  include_std_job_list = false
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_view.foo", "type", "Dashboard"),
+					resource.TestCheckResourceAttr("jenkins_view.foo", "include_std_job_list", "false"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_view foo {
+				  name = "tf-acc-test-%s"
+				  type = "NestedView"
+
+				  assigned_projects = ["example"]
+				}`, randString),
+				ExpectError: regexp.MustCompile(`The "assigned_projects" attribute only applies to`),
+			},
+		},
+	})
+}
+
+func TestAccJenkinsView_myView(t *testing.T) {
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders,
+		CheckDestroy:             testAccCheckJenkinsViewDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource jenkins_view foo {
+				  name = "tf-acc-test-%s"
+				  type = "MyView"
+				}`, randString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jenkins_view.foo", "type", "MyView"),
+				),
+			},
+			{
+				ResourceName:      "jenkins_view.foo",
+				ImportState:       true,
+				ImportStateId:     "tf-acc-test-" + randString,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -40,7 +288,7 @@ func testAccCheckJenkinsViewDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := testAccClient.GetJob(ctx, rs.Primary.ID)
+		_, err := testAccClient.GetViewInFolder(ctx, rs.Primary.Attributes["folder"], rs.Primary.Attributes["name"])
 		if err == nil {
 			return fmt.Errorf("View %s still exists", rs.Primary.ID)
 		}
@@ -48,3 +296,83 @@ func testAccCheckJenkinsViewDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func Test_setDifference(t *testing.T) {
+	got := setDifference([]string{"a", "b", "c"}, []string{"b", "d"})
+	if !reflect.DeepEqual(got, []string{"a", "c"}) {
+		t.Errorf("setDifference() = %v, want %v", got, []string{"a", "c"})
+	}
+
+	got = setDifference(nil, []string{"a"})
+	if len(got) != 0 {
+		t.Errorf("setDifference() = %v, want empty", got)
+	}
+}
+
+func Test_ViewResourceModel_jobFilters(t *testing.T) {
+	ctx := context.Background()
+	config := `<hudson.model.ListView>
+  <name>team</name>
+  <jobFilters>
+    <hudson.views.OtherViewsFilter plugin="view-job-filters@2.3">
+      <includeExcludeTypeString>includeMatched</includeExcludeTypeString>
+      <otherViews><string>x</string></otherViews>
+    </hudson.views.OtherViewsFilter>
+    <hudson.views.RegExJobFilter plugin="view-job-filters@2.3">
+      <regex>a&amp;b</regex>
+    </hudson.views.RegExJobFilter>
+  </jobFilters>
+</hudson.model.ListView>`
+
+	v, err := parseView(config)
+	if err != nil {
+		t.Fatalf("parseView() error = %v", err)
+	}
+
+	m := ViewResourceModel{}
+	if diags := m.flatten(ctx, v); diags.HasError() {
+		t.Fatalf("flatten() error = %v", diags)
+	}
+
+	var filters []ViewJobFilter
+	m.JobFilters.ElementsAs(ctx, &filters, false)
+	if len(filters) != 2 {
+		t.Fatalf("flatten() job filters = %v", m.JobFilters)
+	}
+	settings := map[string]string{}
+	filters[0].Settings.ElementsAs(ctx, &settings, false)
+	if !reflect.DeepEqual(settings, map[string]string{"includeExcludeTypeString": "includeMatched"}) {
+		t.Errorf("flatten() settings = %v, want the nested setting to be left out", settings)
+	}
+	filters[1].Settings.ElementsAs(ctx, &settings, false)
+	if settings["regex"] != "a&b" {
+		t.Errorf("flatten() regex = %q, want %q", settings["regex"], "a&b")
+	}
+
+	// Apply the flattened filters back onto the view, changing a setting of the first filter
+	filters[0].Settings = types.MapValueMust(types.StringType, map[string]attr.Value{
+		"includeExcludeTypeString": types.StringValue("excludeMatched"),
+	})
+	m.JobFilters = types.ListValueMust(viewJobFilterType, []attr.Value{
+		types.ObjectValueMust(viewJobFilterType.AttrTypes, map[string]attr.Value{"class": filters[0].Class, "settings": filters[0].Settings}),
+		types.ObjectValueMust(viewJobFilterType.AttrTypes, map[string]attr.Value{"class": filters[1].Class, "settings": filters[1].Settings}),
+	})
+	if diags := m.expand(ctx, v); diags.HasError() {
+		t.Fatalf("expand() error = %v", diags)
+	}
+
+	rendered, err := v.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	for _, want := range []string{
+		`<hudson.views.OtherViewsFilter plugin="view-job-filters@2.3">`,
+		`<includeExcludeTypeString>excludeMatched</includeExcludeTypeString>`,
+		`<otherViews><string>x</string></otherViews>`,
+		`<regex>a&amp;b</regex>`,
+	} {
+		if !strings.Contains(string(rendered), want) {
+			t.Errorf("Render() = %s, want it to contain %s", rendered, want)
+		}
+	}
+}
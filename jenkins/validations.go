@@ -2,6 +2,7 @@ package jenkins
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/go-cty/cty"
@@ -32,3 +33,25 @@ func validateCredentialScope(val interface{}, path cty.Path) diag.Diagnostics {
 	}
 	return diag.Errorf("Invalid scope: %s. Supported scopes are: %s", val, strings.Join(supportedCredentialScopes, ", "))
 }
+
+func validateFolderPermissionType(val interface{}, path cty.Path) diag.Diagnostics {
+	for _, supported := range folderPermissionTypes {
+		if val == supported {
+			return diag.Diagnostics{}
+		}
+	}
+	return diag.Errorf("Invalid permission type: %s. Supported types are: %s", val, strings.Join(folderPermissionTypes, ", "))
+}
+
+func validateFolderPermissionName(val interface{}, path cty.Path) diag.Diagnostics {
+	if _, ok := folderPermissionCatalog[val.(string)]; ok {
+		return diag.Diagnostics{}
+	}
+
+	supported := []string{}
+	for name := range folderPermissionCatalog {
+		supported = append(supported, name)
+	}
+	sort.Strings(supported)
+	return diag.Errorf("Invalid permission: %s. Supported permissions are: %s", val, strings.Join(supported, ", "))
+}
@@ -54,3 +54,41 @@ func TestValidateCredentialScope(t *testing.T) {
 		t.Errorf("Error, negative validation failed for input: %s", input)
 	}
 }
+
+func TestValidateFolderPermissionType(t *testing.T) {
+
+	input, ctyPath := "USER", make(cty.Path, 0)
+	actual := validateFolderPermissionType(input, ctyPath)
+	if actual.HasError() {
+		t.Errorf("Error, validation failed for input: %s", input)
+	}
+
+	input = "GROUP"
+	actual = validateFolderPermissionType(input, ctyPath)
+	if actual.HasError() {
+		t.Errorf("Error, validation failed for input: %s", input)
+	}
+
+	// Test if we fail when we should
+	input = "user"
+	actual = validateFolderPermissionType(input, ctyPath)
+	if !actual.HasError() {
+		t.Errorf("Error, negative validation failed for input: %s", input)
+	}
+}
+
+func TestValidateFolderPermissionName(t *testing.T) {
+
+	input, ctyPath := "Job/Read", make(cty.Path, 0)
+	actual := validateFolderPermissionName(input, ctyPath)
+	if actual.HasError() {
+		t.Errorf("Error, validation failed for input: %s", input)
+	}
+
+	// Test if we fail when we should
+	input = "hudson.model.Item.Read"
+	actual = validateFolderPermissionName(input, ctyPath)
+	if !actual.HasError() {
+		t.Errorf("Error, negative validation failed for input: %s", input)
+	}
+}
@@ -0,0 +1,242 @@
+package jenkins
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	jenkins "github.com/bndr/gojenkins"
+)
+
+// viewClasses maps the supported view types to the classes used as the root element of their configuration.
+var viewClasses = map[string]string{
+	"ListView":            "hudson.model.ListView",
+	"MyView":              "hudson.model.MyView",
+	"NestedView":          "hudson.plugins.nested__view.NestedView",
+	"Dashboard":           "hudson.plugins.view.dashboard.Dashboard",
+	"CategorizedJobsView": "org.jenkinsci.plugins.categorizedview.CategorizedJobsView",
+	"BuildPipelineView":   "au.com.centrumsystems.hudson.plugin.buildpipeline.BuildPipelineView",
+}
+
+// viewTypes lists the supported view types in a stable order, for use within validation and documentation.
+var viewTypes = []string{"ListView", "MyView", "NestedView", "Dashboard", "CategorizedJobsView", "BuildPipelineView"}
+
+// view represents the configuration of a view. Elements specific to view types that are not
+// supported are retained as-is, so that they are not lost when the view is updated.
+type view struct {
+	XMLName     xml.Name
+	Plugin      string `xml:"plugin,attr,omitempty"`
+	Name        string `xml:"name"`
+	Description string `xml:"description,omitempty"`
+
+	// ListView and the view types derived from it
+	JobNames     *viewJobNames   `xml:"jobNames,omitempty"`
+	IncludeRegex string          `xml:"includeRegex,omitempty"`
+	Recurse      *bool           `xml:"recurse,omitempty"`
+	Columns      *viewColumns    `xml:"columns,omitempty"`
+	JobFilters   *viewJobFilters `xml:"jobFilters,omitempty"`
+
+	// NestedView
+	DefaultView string `xml:"defaultView,omitempty"`
+
+	// Dashboard
+	IncludeStdJobList *bool `xml:"includeStdJobList,omitempty"`
+
+	// CategorizedJobsView
+	CategorizationCriteria *viewCategorizationCriteria `xml:"categorizationCriteria,omitempty"`
+
+	// BuildPipelineView
+	GridBuilder         *viewGridBuilder `xml:"gridBuilder,omitempty"`
+	NoOfDisplayedBuilds string           `xml:"noOfDisplayedBuilds,omitempty"`
+
+	Other []xmlRawProperty `xml:",any"`
+}
+
+// viewJobNames holds the names of the jobs explicitly assigned to a view, relative to the folder of the view.
+type viewJobNames struct {
+	Comparator *xmlRawProperty `xml:"comparator,omitempty"`
+	Names      []string        `xml:"string"`
+}
+
+// viewColumns holds the ordered columns of a view. The settings of each column are retained as-is.
+type viewColumns struct {
+	Columns []xmlRawProperty `xml:",any"`
+}
+
+type viewJobFilters struct {
+	Filters []viewJobFilter `xml:",any"`
+}
+
+// viewJobFilter is a filter narrowing down the jobs of a view, named after its class. Its settings are
+// stored as child elements, e.g. <regex>.*</regex>, and are kept raw as some of them nest further elements.
+type viewJobFilter struct {
+	XMLName  xml.Name
+	Plugin   string           `xml:"plugin,attr,omitempty"`
+	Settings []xmlRawProperty `xml:",any"`
+}
+
+// viewJobFilterSettingValue returns the text of a job filter setting, or false if the setting nests
+// further elements and so cannot be represented as text.
+func viewJobFilterSettingValue(setting xmlRawProperty) (string, bool) {
+	if strings.Contains(setting.Raw, "<") {
+		return "", false
+	}
+
+	parsed := struct {
+		Value string `xml:",chardata"`
+	}{}
+	if err := xml.Unmarshal([]byte("<setting>"+setting.Raw+"</setting>"), &parsed); err != nil {
+		return "", false
+	}
+
+	return parsed.Value, true
+}
+
+// setViewJobFilterSettingValue replaces the content of a job filter setting with the given text.
+func setViewJobFilterSettingValue(setting *xmlRawProperty, value string) {
+	var raw strings.Builder
+	_ = xml.EscapeText(&raw, []byte(value))
+	setting.Raw = raw.String()
+}
+
+type viewCategorizationCriteria struct {
+	GroupingRules []viewGroupingRule `xml:"org.jenkinsci.plugins.categorizedview.GroupingRule"`
+}
+
+type viewGroupingRule struct {
+	GroupRegex string `xml:"groupRegex"`
+	NamingRule string `xml:"namingRule"`
+}
+
+type viewGridBuilder struct {
+	Class    string `xml:"class,attr"`
+	FirstJob string `xml:"firstJob"`
+}
+
+// newView returns the configuration of a new, empty view of the given type.
+func newView(viewType, name string) *view {
+	return &view{
+		XMLName: xml.Name{Local: viewClasses[viewType]},
+		Name:    name,
+	}
+}
+
+func parseView(config string) (*view, error) {
+	ret := &view{}
+
+	doc := handleXml(config)
+	if err := xml.Unmarshal(doc, &ret); err != nil {
+		return ret, fmt.Errorf("could not parse view XML: %w", err)
+	}
+
+	return ret, nil
+}
+
+func (v *view) Render() ([]byte, error) {
+	return xml.MarshalIndent(v, "", "\t")
+}
+
+// Type returns the view type of the configuration, or its class if the type is not supported.
+func (v *view) Type() string {
+	for viewType, class := range viewClasses {
+		if class == v.XMLName.Local {
+			return viewType
+		}
+	}
+	return v.XMLName.Local
+}
+
+// viewURL returns the URL of the given view, relative to the folder containing it.
+func viewURL(folder, name string) string {
+	return formatFolderID(extractFolders(folder)) + "/view/" + url.PathEscape(name)
+}
+
+// viewID returns the unique identifier of a view. Views at the root of Jenkins are identified by their name,
+// whilst views within folders are identified by their path, e.g. "/job/folder/view/name".
+func viewID(folder, name string) string {
+	if len(extractFolders(folder)) == 0 {
+		return name
+	}
+	return formatFolderID(extractFolders(folder)) + "/view/" + name
+}
+
+// parseViewPath extracts the folder and name of a view from either its path, e.g. "folder-name/view-name", or its
+// URL as found in the browser, e.g. "https://jenkins.example.com/job/folder-name/view/view-name/". The given server
+// URL is stripped from the start of view URLs, so that controllers hosted under a context path are supported.
+func parseViewPath(server, id string) (folder, name string, err error) {
+	p := id
+	if u, err := url.Parse(id); err == nil && u.Scheme != "" {
+		p = u.Path
+		if s, err := url.Parse(server); err == nil {
+			p = strings.TrimPrefix(p, strings.TrimSuffix(s.Path, "/"))
+		}
+	}
+
+	segments := strings.Split(strings.Trim(p, "/"), "/")
+
+	// Paths following the structure of Jenkins URLs, e.g. "/job/folder-name/view/view-name"
+	if i := slices.Index(segments, "view"); i >= 0 && (i == 0 || segments[0] == "job") {
+		if i != len(segments)-2 {
+			return "", "", fmt.Errorf("expected a single view name following the view segment of %q", id)
+		}
+		segments = append(segments[:i], segments[i+1])
+	}
+
+	name = segments[len(segments)-1]
+	if name == "" {
+		return "", "", fmt.Errorf("no view name found within %q", id)
+	}
+
+	return formatFolderID(extractFolders(strings.Join(segments[:len(segments)-1], "/"))), name, nil
+}
+
+// GetViewInFolder retrieves a view, which may be contained within a folder.
+func (j *jenkinsAdapter) GetViewInFolder(ctx context.Context, folder, name string) (*jenkins.View, error) {
+	v := &jenkins.View{Jenkins: j.Jenkins, Raw: new(jenkins.ViewResponse), Base: viewURL(folder, name)}
+	if _, err := v.Poll(ctx); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetViewConfig retrieves the configuration of a view.
+func (j *jenkinsAdapter) GetViewConfig(ctx context.Context, folder, name string) (*view, error) {
+	output, err := j.request(ctx, "GET", viewURL(folder, name)+"/config.xml", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseView(output)
+}
+
+// CreateViewFromConfig creates a new view within the given folder from the given configuration.
+func (j *jenkinsAdapter) CreateViewFromConfig(ctx context.Context, folder string, v *view) error {
+	payload, err := v.Render()
+	if err != nil {
+		return err
+	}
+
+	endpoint := formatFolderID(extractFolders(folder)) + "/createView?" + url.Values{"name": {v.Name}}.Encode()
+	_, err = j.request(ctx, "POST", endpoint, "application/xml", strings.NewReader(string(payload)))
+	return err
+}
+
+// UpdateViewConfig replaces the configuration of an existing view.
+func (j *jenkinsAdapter) UpdateViewConfig(ctx context.Context, folder, name string, v *view) error {
+	payload, err := v.Render()
+	if err != nil {
+		return err
+	}
+
+	_, err = j.request(ctx, "POST", viewURL(folder, name)+"/config.xml", "application/xml", strings.NewReader(string(payload)))
+	return err
+}
+
+// DeleteView removes a view. The jobs within the view are left untouched.
+func (j *jenkinsAdapter) DeleteView(ctx context.Context, folder, name string) error {
+	_, err := j.request(ctx, "POST", viewURL(folder, name)+"/doDelete", "", nil)
+	return err
+}
@@ -0,0 +1,270 @@
+package jenkins
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func Test_parseView(t *testing.T) {
+	config := `<?xml version='1.1' encoding='UTF-8'?>
+<hudson.plugins.nested__view.NestedView plugin="nested-view@1.33">
+  <name>dashboards</name>
+  <description>All team dashboards</description>
+  <filterExecutors>false</filterExecutors>
+  <properties class="hudson.model.View$PropertyList"/>
+  <views>
+    <hudson.model.ListView>
+      <name>child</name>
+    </hudson.model.ListView>
+  </views>
+  <defaultView>child</defaultView>
+</hudson.plugins.nested__view.NestedView>`
+
+	v, err := parseView(config)
+	if err != nil {
+		t.Fatalf("parseView() error = %v", err)
+	}
+
+	if v.Type() != "NestedView" {
+		t.Errorf("Type() = %q, want %q", v.Type(), "NestedView")
+	}
+	if v.Name != "dashboards" || v.Description != "All team dashboards" || v.DefaultView != "child" {
+		t.Errorf("parseView() = %+v", v)
+	}
+
+	// Unmanaged elements must survive a round trip
+	rendered, err := v.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	for _, want := range []string{
+		`<hudson.plugins.nested__view.NestedView plugin="nested-view@1.33">`,
+		`<filterExecutors>false</filterExecutors>`,
+		`<hudson.model.ListView>`,
+		`<defaultView>child</defaultView>`,
+	} {
+		if !strings.Contains(string(rendered), want) {
+			t.Errorf("Render() = %s, want it to contain %s", rendered, want)
+		}
+	}
+}
+
+func Test_parseView_listView(t *testing.T) {
+	config := `<?xml version='1.1' encoding='UTF-8'?>
+<hudson.model.ListView>
+  <name>team</name>
+  <filterExecutors>false</filterExecutors>
+  <jobNames>
+    <comparator class="java.lang.String$CaseInsensitiveComparator"/>
+    <string>build</string>
+  </jobNames>
+  <jobFilters>
+    <hudson.views.RegExJobFilter plugin="view-job-filters@2.3">
+      <includeExcludeTypeString>includeMatched</includeExcludeTypeString>
+      <regex>deploy-.*</regex>
+    </hudson.views.RegExJobFilter>
+  </jobFilters>
+  <columns>
+    <hudson.views.StatusColumn/>
+    <hudson.views.JobColumn/>
+    <jenkins.branch.DescriptionColumn plugin="branch-api@2.1217"/>
+  </columns>
+  <includeRegex>team-.*</includeRegex>
+  <recurse>true</recurse>
+</hudson.model.ListView>`
+
+	v, err := parseView(config)
+	if err != nil {
+		t.Fatalf("parseView() error = %v", err)
+	}
+
+	if v.JobNames == nil || len(v.JobNames.Names) != 1 || v.JobNames.Names[0] != "build" {
+		t.Errorf("parseView() job names = %+v", v.JobNames)
+	}
+	if v.IncludeRegex != "team-.*" || v.Recurse == nil || !*v.Recurse {
+		t.Errorf("parseView() = %+v", v)
+	}
+	if v.Columns == nil || len(v.Columns.Columns) != 3 || v.Columns.Columns[2].XMLName.Local != "jenkins.branch.DescriptionColumn" {
+		t.Errorf("parseView() columns = %+v", v.Columns)
+	}
+	if v.JobFilters == nil || len(v.JobFilters.Filters) != 1 {
+		t.Fatalf("parseView() job filters = %+v", v.JobFilters)
+	}
+	filter := v.JobFilters.Filters[0]
+	if filter.XMLName.Local != "hudson.views.RegExJobFilter" || len(filter.Settings) != 2 || filter.Settings[1].Raw != "deploy-.*" {
+		t.Errorf("parseView() job filter = %+v", filter)
+	}
+
+	rendered, err := v.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	for _, want := range []string{
+		`<includeRegex>team-.*</includeRegex>`,
+		`<recurse>true</recurse>`,
+		`<hudson.views.RegExJobFilter plugin="view-job-filters@2.3">`,
+		`<regex>deploy-.*</regex>`,
+		`<jenkins.branch.DescriptionColumn plugin="branch-api@2.1217">`,
+		`<comparator class="java.lang.String$CaseInsensitiveComparator">`,
+		`<string>build</string>`,
+	} {
+		if !strings.Contains(string(rendered), want) {
+			t.Errorf("Render() = %s, want it to contain %s", rendered, want)
+		}
+	}
+}
+
+func Test_view_Type(t *testing.T) {
+	tests := []struct {
+		config string
+		want   string
+	}{
+		{`<hudson.model.MyView><name>mine</name></hudson.model.MyView>`, "MyView"},
+		{`<hudson.model.ListView><name>list</name></hudson.model.ListView>`, "ListView"},
+		{`<hudson.model.AllView><name>all</name></hudson.model.AllView>`, "hudson.model.AllView"},
+	}
+
+	for _, tt := range tests {
+		v, err := parseView(tt.config)
+		if err != nil {
+			t.Fatalf("parseView(%q) error = %v", tt.config, err)
+		}
+		if got := v.Type(); got != tt.want {
+			t.Errorf("Type() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func Test_newView(t *testing.T) {
+	for _, viewType := range viewTypes {
+		if got := newView(viewType, "example").Type(); got != viewType {
+			t.Errorf("newView(%q).Type() = %q", viewType, got)
+		}
+	}
+}
+
+func Test_viewID(t *testing.T) {
+	tests := []struct {
+		folder string
+		name   string
+		want   string
+		url    string
+	}{
+		{folder: "", name: "example", want: "example", url: "/view/example"},
+		{folder: "team", name: "example", want: "/job/team/view/example", url: "/job/team/view/example"},
+		{folder: "/job/team/job/nested", name: "my view", want: "/job/team/job/nested/view/my view", url: "/job/team/job/nested/view/my%20view"},
+	}
+	for _, tt := range tests {
+		if got := viewID(tt.folder, tt.name); got != tt.want {
+			t.Errorf("viewID(%q, %q) = %q, want %q", tt.folder, tt.name, got, tt.want)
+		}
+		if got := viewURL(tt.folder, tt.name); got != tt.url {
+			t.Errorf("viewURL(%q, %q) = %q, want %q", tt.folder, tt.name, got, tt.url)
+		}
+	}
+}
+
+func Test_parseViewPath(t *testing.T) {
+	tests := []struct {
+		server  string
+		id      string
+		folder  string
+		name    string
+		wantErr bool
+	}{
+		{id: "example", name: "example"},
+		{id: "team/nested/example", folder: "/job/team/job/nested", name: "example"},
+		{id: "/job/team/view/example", folder: "/job/team", name: "example"},
+		{id: "view/example", name: "example"},
+		{server: "https://jenkins.example.com", id: "https://jenkins.example.com/view/example/", name: "example"},
+		{server: "https://jenkins.example.com", id: "https://jenkins.example.com/job/team/job/nested/view/my%20view/", folder: "/job/team/job/nested", name: "my view"},
+		{server: "https://example.com/jenkins/", id: "https://example.com/jenkins/job/team/view/example", folder: "/job/team", name: "example"},
+		{id: "/job/team/view/parent/view/child", wantErr: true},
+		{id: "team/", folder: "", name: "team"},
+		{id: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		folder, name, err := parseViewPath(tt.server, tt.id)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseViewPath(%q) error = %v, wantErr %v", tt.id, err, tt.wantErr)
+			continue
+		}
+		if folder != tt.folder || name != tt.name {
+			t.Errorf("parseViewPath(%q) = %q, %q, want %q, %q", tt.id, folder, name, tt.folder, tt.name)
+		}
+	}
+}
+
+func TestJenkinsAdapter_View(t *testing.T) {
+	stored := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/job/team/createView" && r.URL.Query().Get("name") == "example":
+			if r.Header.Get("Content-Type") != "application/xml" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			body, _ := io.ReadAll(r.Body)
+			stored = string(body)
+		case r.Method == "GET" && r.URL.Path == "/job/team/view/example/config.xml/":
+			if stored == "" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(stored))
+		case r.Method == "GET" && r.URL.Path == "/job/team/view/example/api/json":
+			_, _ = w.Write([]byte(`{"name":"example","url":"http://jenkins/job/team/view/example/","jobs":[{"name":"build"}]}`))
+		case r.Method == "POST" && r.URL.Path == "/job/team/view/example/config.xml":
+			body, _ := io.ReadAll(r.Body)
+			stored = string(body)
+		case r.Method == "POST" && r.URL.Path == "/job/team/view/example/doDelete":
+			stored = ""
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	c := newJenkinsClient(&Config{ServerURL: server.URL})
+
+	v := newView("Dashboard", "example")
+	if err := c.CreateViewFromConfig(ctx, "team", v); err != nil {
+		t.Fatalf("CreateViewFromConfig() error = %v", err)
+	}
+
+	got, err := c.GetViewConfig(ctx, "/job/team", "example")
+	if err != nil {
+		t.Fatalf("GetViewConfig() error = %v", err)
+	}
+	if got.Type() != "Dashboard" || got.Name != "example" {
+		t.Errorf("GetViewConfig() = %+v", got)
+	}
+
+	info, err := c.GetViewInFolder(ctx, "/job/team", "example")
+	if err != nil {
+		t.Fatalf("GetViewInFolder() error = %v", err)
+	}
+	if info.Base != "/job/team/view/example" || len(info.GetJobs()) != 1 {
+		t.Errorf("GetViewInFolder() = %+v", info.Raw)
+	}
+
+	got.Description = "Updated"
+	if err := c.UpdateViewConfig(ctx, "/job/team", "example", got); err != nil {
+		t.Fatalf("UpdateViewConfig() error = %v", err)
+	}
+	if got, _ := c.GetViewConfig(ctx, "/job/team", "example"); got.Description != "Updated" {
+		t.Errorf("UpdateViewConfig() description = %q, want %q", got.Description, "Updated")
+	}
+
+	if err := c.DeleteView(ctx, "team", "example"); err != nil {
+		t.Fatalf("DeleteView() error = %v", err)
+	}
+	if _, err := c.GetViewConfig(ctx, "/job/team", "example"); err == nil || !strings.HasSuffix(err.Error(), "404") {
+		t.Errorf("GetViewConfig() error = %v, want 404", err)
+	}
+}